@@ -40,36 +40,59 @@ type Application struct {
 	screen       tcell.Screen
 	prevMouseEvt *tcell.EventMouse
 	root         Component
+	layers       []*Layer
 	updates      chan interface{}
 	redrawTicker *time.Ticker
 	stop         chan struct{}
 	waitForStop  chan struct{}
 	alwaysClear  bool
+	backBuffer   *VirtualScreen
+	rootScreen   *ProxyScreen
+
+	// Layer changes made while the application is running, see Application.AddLayer.
+	layerUpdates     []layerUpdate
+	layerUpdatesLock sync.Mutex
+
+	keymap         *Keymap
+	keyChord       ChordState
+	keyActions     map[string]func() bool
+	keyActionsLock sync.RWMutex
+
+	focusManager *FocusManager
 }
 
 const queueSize = 255
 
 func NewApplication() *Application {
-	return &Application{
+	app := &Application{
 		prevMouseEvt: &tcell.EventMouse{},
 		updates:      make(chan interface{}, queueSize),
 		redrawTicker: time.NewTicker(1 * time.Minute),
 		stop:         make(chan struct{}, 1),
 		alwaysClear:  true,
+		keymap:       NewKeymap(AppKeymapName),
+		keyActions:   make(map[string]func() bool),
 	}
+	app.focusManager = &FocusManager{app: app}
+	return app
 }
 
 func newScreen(events chan tcell.Event) (tcell.Screen, error) {
 	if screen, err := tcell.NewScreen(); err != nil {
 		return nil, fmt.Errorf("failed to create screen: %w", err)
-	} else if err = screen.Init(); err != nil {
-		return nil, fmt.Errorf("failed to initialize screen: %w", err)
 	} else {
-		screen.EnableMouse()
-		screen.EnablePaste()
-		go screen.ChannelEvents(events, nil)
-		return screen, nil
+		return screen, initScreen(screen, events)
+	}
+}
+
+func initScreen(screen tcell.Screen, events chan tcell.Event) error {
+	if err := screen.Init(); err != nil {
+		return fmt.Errorf("failed to initialize screen: %w", err)
 	}
+	screen.EnableMouse()
+	screen.EnablePaste()
+	go screen.ChannelEvents(events, nil)
+	return nil
 }
 
 func (app *Application) SetRedrawTicker(tick time.Duration) {
@@ -78,12 +101,26 @@ func (app *Application) SetRedrawTicker(tick time.Duration) {
 }
 
 func (app *Application) Start() error {
+	return app.StartWithScreen(nil)
+}
+
+// StartWithScreen starts the application on the given tcell screen instead of creating a new terminal screen.
+// The screen must not be initialized yet, the application will initialize it and finalize it when stopping.
+//
+// This is mostly useful for running applications against a tcell.SimulationScreen, see also NewSimulation.
+// If the screen is nil, this is equivalent to Start.
+func (app *Application) StartWithScreen(screen tcell.Screen) error {
 	if app.root == nil {
 		return errors.New("root component not set")
 	}
 
+	var err error
 	events := make(chan tcell.Event, queueSize)
-	screen, err := newScreen(events)
+	if screen == nil {
+		screen, err = newScreen(events)
+	} else {
+		err = initScreen(screen, events)
+	}
 	if err != nil {
 		return err
 	}
@@ -105,6 +142,7 @@ func (app *Application) Start() error {
 
 	var pasteBuffer strings.Builder
 	var isPasting bool
+	var syncs []drawSync
 
 	for {
 		var redraw bool
@@ -120,8 +158,10 @@ func (app *Application) Start() error {
 					case tcell.KeyEnter:
 						pasteBuffer.WriteByte('\n')
 					}
+				} else if consumed, actionRedraw := app.handleKeyBinding(event); consumed {
+					redraw = actionRedraw
 				} else {
-					redraw = app.root.OnKeyEvent(event)
+					redraw = app.dispatchKeyEvent(event) || actionRedraw
 				}
 			case *tcell.EventPaste:
 				if event.Start() {
@@ -131,17 +171,26 @@ func (app *Application) Start() error {
 					customEvt := customPasteEvent{event, pasteBuffer.String()}
 					isPasting = false
 					pasteBuffer.Reset()
-					redraw = app.root.OnPasteEvent(customEvt)
+					redraw = app.dispatchEvent(func(comp Component) bool {
+						return comp.OnPasteEvent(customEvt)
+					})
 				}
 			case *tcell.EventMouse:
 				onlyButtons := event.Buttons() < tcell.WheelUp
 				hasMotion := onlyButtons && app.prevMouseEvt.Buttons() == event.Buttons()
 				customEvt := customMouseEvent{event, hasMotion}
 				app.prevMouseEvt = event
-				redraw = app.root.OnMouseEvent(customEvt)
+				redraw = app.dispatchEvent(func(comp Component) bool {
+					return comp.OnMouseEvent(customEvt)
+				})
 			case *tcell.EventResize:
 				clear = true
 				redraw = true
+			case *tcell.EventInterrupt:
+				if ds, ok := event.Data().(drawSync); ok {
+					syncs = append(syncs, ds)
+					redraw = true
+				}
 			}
 		case <-app.redrawTicker.C:
 			redraw = true
@@ -172,6 +221,9 @@ func (app *Application) Start() error {
 				}
 				redraw = true
 				clear = true
+			case drawSync:
+				syncs = append(syncs, updater)
+				redraw = true
 			}
 		case <-app.stop:
 			return nil
@@ -181,17 +233,60 @@ func (app *Application) Start() error {
 			return nil
 		default:
 		}
+		if updated, removed := app.applyLayerUpdates(); updated {
+			redraw = true
+			clear = clear || removed
+		}
+		app.focusManager.checkChanged()
 		if redraw {
-			if clear || app.alwaysClear {
-				screen.Clear()
+			app.draw(screen, clear)
+			for _, ds := range syncs {
+				close(ds)
 			}
-			screen.HideCursor()
-			app.root.Draw(screen)
-			screen.Show()
+			syncs = syncs[:0]
 		}
 	}
 }
 
+func (app *Application) draw(screen tcell.Screen, clear bool) {
+	if app.alwaysClear {
+		app.backBuffer = nil
+		screen.Clear()
+		screen.HideCursor()
+		app.root.Draw(screen)
+		app.drawLayers(screen)
+		screen.Show()
+		return
+	}
+	// When the screen isn't cleared on every draw, draw into a back buffer and only push changed cells to tcell.
+	// The back buffer keeps the previous frame, so only dirty components need to be redrawn (see Dirtyable).
+	width, height := screen.Size()
+	if app.backBuffer == nil {
+		app.backBuffer = NewVirtualScreen(width, height)
+		app.rootScreen = &ProxyScreen{Parent: app.backBuffer, Style: tcell.StyleDefault}
+		clear = true
+	} else if bufWidth, bufHeight := app.backBuffer.Size(); bufWidth != width || bufHeight != height {
+		app.backBuffer.Resize(width, height)
+		clear = true
+	}
+	if clear {
+		screen.Clear()
+		app.backBuffer.Clear()
+		app.backBuffer.Invalidate()
+	} else if len(app.layers) > 0 {
+		// Layers can cover any part of the root component, so the previous frame can't be reused.
+		// The back buffer still makes sure that only changed cells are pushed to the screen.
+		app.backBuffer.Clear()
+		clear = true
+	}
+	app.rootScreen.Width, app.rootScreen.Height = width, height
+	app.backBuffer.HideCursor()
+	drawChild(app.root, app.rootScreen, !clear, tcell.StyleDefault)
+	app.drawLayers(app.backBuffer)
+	app.backBuffer.Flush(screen)
+	screen.Show()
+}
+
 func (app *Application) Stop() {
 	select {
 	case app.stop <- struct{}{}:
@@ -216,6 +311,10 @@ type suspendUpdate struct {
 
 type redrawUpdate struct{}
 
+// drawSync is closed by the main loop after the next draw. It can be sent either through the updates channel,
+// or inside a tcell.EventInterrupt to also wait for all previously queued tcell events to be handled.
+type drawSync chan struct{}
+
 type setRootUpdate struct {
 	newRoot Component
 }
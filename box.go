@@ -28,6 +28,10 @@ type Box struct {
 	inner           Component
 	innerScreen     *ProxyScreen
 	focused         bool
+
+	prevWidth  int
+	prevHeight int
+	prevBorder bool
 }
 
 func NewBox(inner Component) *Box {
@@ -66,6 +70,52 @@ func (box *Box) Blur() {
 	}
 }
 
+// Children returns the inner component of the box.
+func (box *Box) Children() []Component {
+	if box.inner == nil {
+		return nil
+	}
+	return []Component{box.inner}
+}
+
+func (box *Box) focusedChild() Component {
+	if box.focused {
+		return box.inner
+	}
+	return nil
+}
+
+func (box *Box) focusChild(comp Component) bool {
+	if comp != box.inner {
+		return false
+	} else if !box.focused {
+		box.Focus()
+	}
+	return true
+}
+
+// ChildArea returns the area of the inner component relative to the box, as of the last draw.
+func (box *Box) ChildArea(comp Component) (Rect, bool) {
+	if comp != box.inner {
+		return Rect{}, false
+	}
+	return box.innerScreen.area(), true
+}
+
+// PreferredSize returns the preferred size of the inner component plus the border.
+// If the inner component doesn't implement Sizer, the whole available space is preferred.
+func (box *Box) PreferredSize(width, height int) (int, int) {
+	border := 0
+	if box.border {
+		border = 2
+	}
+	innerWidth, innerHeight, ok := preferredSize(box.inner, max(width-border, 0), max(height-border, 0))
+	if !ok {
+		return width, height
+	}
+	return innerWidth + border, innerHeight + border
+}
+
 func (box *Box) SetBorder(border bool) *Box {
 	box.border = border
 	if border {
@@ -160,15 +210,22 @@ func (box *Box) drawBorder(screen Screen) {
 	screen.SetContent(width-1, height-1, bottomRight, nil, borderStyle)
 }
 
+func (box *Box) drawsPartially() {}
+
 func (box *Box) Draw(screen Screen) {
 	width, height := screen.Size()
-	border := false
+	border := box.border && width >= 2 && height >= 2
+	partial := isPartialDraw(screen) && box.prevWidth == width && box.prevHeight == height && box.prevBorder == border
+	box.prevWidth, box.prevHeight, box.prevBorder = width, height, border
+	clearStyle := proxyStyle(screen)
 	if box.backgroundColor != nil {
-		screen.SetStyle(tcell.StyleDefault.Background(*box.backgroundColor))
-		screen.Clear()
+		clearStyle = tcell.StyleDefault.Background(*box.backgroundColor)
+		screen.SetStyle(clearStyle)
+		if !partial {
+			screen.Clear()
+		}
 	}
-	if box.border && width >= 2 && height >= 2 {
-		border = true
+	if border {
 		box.drawBorder(screen)
 	}
 
@@ -181,7 +238,7 @@ func (box *Box) Draw(screen Screen) {
 			box.innerScreen.Height = height
 		}
 		box.innerScreen.Parent = screen
-		box.inner.Draw(box.innerScreen)
+		drawChild(box.inner, box.innerScreen, partial, clearStyle)
 	}
 }
 
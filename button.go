@@ -75,6 +75,11 @@ func (b *Button) Blur() {
 	b.focused = false
 }
 
+// PreferredSize returns the width of the text with one cell of padding on both sides, and a height of one line.
+func (b *Button) PreferredSize(width, height int) (int, int) {
+	return TaggedStringWidth(b.text) + 2, 1
+}
+
 func (b *Button) Draw(screen Screen) {
 	width, _ := screen.Size()
 	style := b.style
@@ -41,6 +41,16 @@ func (fc *FractionalCenterer) OnMouseEvent(evt MouseEvent) bool { return fc.cent
 func (fc *FractionalCenterer) OnKeyEvent(evt KeyEvent) bool     { return fc.center.OnKeyEvent(evt) }
 func (fc *FractionalCenterer) OnPasteEvent(evt PasteEvent) bool { return fc.center.OnPasteEvent(evt) }
 
+func (fc *FractionalCenterer) Children() []Component { return fc.center.Children() }
+func (fc *FractionalCenterer) ChildArea(comp Component) (Rect, bool) {
+	return fc.center.ChildArea(comp)
+}
+
+func (fc *FractionalCenterer) focusedChild() Component        { return fc.center.focusedChild() }
+func (fc *FractionalCenterer) focusChild(comp Component) bool { return fc.center.focusChild(comp) }
+
+func (fc *FractionalCenterer) drawsPartially() {}
+
 func (fc *FractionalCenterer) Draw(screen Screen) {
 	width, height := screen.Size()
 	width = int(float64(width) * fc.fractionWidth)
@@ -60,6 +70,7 @@ type Centerer struct {
 	screen           *ProxyScreen
 	childFocused     bool
 	alwaysFocusChild bool
+	prevArea         Rect
 }
 
 func Center(target Component, width, height int) *Centerer {
@@ -89,6 +100,8 @@ func (center *Centerer) SetAlwaysFocusChild(always bool) *Centerer {
 	return center
 }
 
+func (center *Centerer) drawsPartially() {}
+
 func (center *Centerer) Draw(screen Screen) {
 	totalWidth, totalHeight := screen.Size()
 	paddingX := (totalWidth - center.screen.Width) / 2
@@ -99,8 +112,15 @@ func (center *Centerer) Draw(screen Screen) {
 	if paddingY >= 0 {
 		center.screen.OffsetY = paddingY
 	}
+	area := Rect{X: center.screen.OffsetX, Y: center.screen.OffsetY, Width: center.screen.Width, Height: center.screen.Height}
+	partial := isPartialDraw(screen) && center.screen.Parent == screen && center.prevArea == area
+	center.prevArea = area
+	if isPartialDraw(screen) && !partial {
+		// The target moved, so the area it previously covered has to be cleared.
+		screen.Clear()
+	}
 	center.screen.Parent = screen
-	center.target.Draw(center.screen)
+	drawChild(center.target, center.screen, partial, proxyStyle(screen))
 }
 
 func (center *Centerer) OnKeyEvent(evt KeyEvent) bool {
@@ -125,6 +145,39 @@ func (center *Centerer) Blur() {
 	}
 }
 
+// Children returns the centered component.
+func (center *Centerer) Children() []Component {
+	return []Component{center.target}
+}
+
+func (center *Centerer) focusedChild() Component {
+	if center.childFocused {
+		return center.target
+	}
+	return nil
+}
+
+func (center *Centerer) focusChild(comp Component) bool {
+	if comp != center.target {
+		return false
+	} else if !center.childFocused {
+		center.childFocused = true
+		focusable, ok := center.target.(Focusable)
+		if ok {
+			focusable.Focus()
+		}
+	}
+	return true
+}
+
+// ChildArea returns the area of the centered component relative to the centerer, as of the last draw.
+func (center *Centerer) ChildArea(comp Component) (Rect, bool) {
+	if comp != center.target {
+		return Rect{}, false
+	}
+	return center.screen.area(), true
+}
+
 func (center *Centerer) OnMouseEvent(evt MouseEvent) bool {
 	x, y := evt.Position()
 	x -= center.screen.OffsetX
@@ -0,0 +1,189 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Checkbox is a single-line form item that can be checked and unchecked with Space, Enter or a click.
+//
+// Inside a Form, Enter toggles the checkbox and moves the focus to the next item like a Button.
+type Checkbox struct {
+	DirtyTracker
+
+	label        string
+	checked      bool
+	focused      bool
+	style        tcell.Style
+	focusedStyle tcell.Style
+
+	// Empty glyphs mean the defaults in Glyphs are used.
+	checkedGlyph   string
+	uncheckedGlyph string
+
+	changed func(checked bool)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// CheckboxKeymap is the default keymap of checkboxes. It can be overridden per checkbox with SetKeymap.
+var CheckboxKeymap = newDefaultKeymap("checkbox", []string{"toggle"}, map[string]string{
+	"Space": "toggle",
+	"Enter": "toggle",
+})
+
+func NewCheckbox(label string) *Checkbox {
+	return &Checkbox{
+		label:        label,
+		style:        tcell.StyleDefault.Background(Styles.PrimitiveBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		focusedStyle: tcell.StyleDefault.Background(Styles.ContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+	}
+}
+
+// SetLabel sets the text shown after the checkbox glyph. Color tags are supported.
+func (cb *Checkbox) SetLabel(label string) *Checkbox {
+	cb.MarkDirty()
+	cb.label = label
+	return cb
+}
+
+func (cb *Checkbox) GetLabel() string {
+	return cb.label
+}
+
+// SetChecked checks or unchecks the checkbox without calling the changed function.
+func (cb *Checkbox) SetChecked(checked bool) *Checkbox {
+	cb.MarkDirty()
+	cb.checked = checked
+	return cb
+}
+
+func (cb *Checkbox) IsChecked() bool {
+	return cb.checked
+}
+
+// Toggle flips the checkbox and calls the changed function.
+func (cb *Checkbox) Toggle() *Checkbox {
+	cb.SetChecked(!cb.checked)
+	if cb.changed != nil {
+		cb.changed(cb.checked)
+	}
+	return cb
+}
+
+// SetGlyphs sets the glyphs drawn for the checked and unchecked states. Empty strings use the defaults in Glyphs.
+func (cb *Checkbox) SetGlyphs(checked, unchecked string) *Checkbox {
+	cb.MarkDirty()
+	cb.checkedGlyph = checked
+	cb.uncheckedGlyph = unchecked
+	return cb
+}
+
+func (cb *Checkbox) SetStyle(style tcell.Style) *Checkbox {
+	cb.MarkDirty()
+	cb.style = style
+	return cb
+}
+
+func (cb *Checkbox) SetFocusedStyle(style tcell.Style) *Checkbox {
+	cb.MarkDirty()
+	cb.focusedStyle = style
+	return cb
+}
+
+// SetChangedFunc sets a function which is called when the checkbox is toggled by the user.
+func (cb *Checkbox) SetChangedFunc(handler func(checked bool)) *Checkbox {
+	cb.changed = handler
+	return cb
+}
+
+// SetKeymap sets the keymap used by this checkbox. If nil, CheckboxKeymap is used.
+func (cb *Checkbox) SetKeymap(keymap *Keymap) *Checkbox {
+	cb.keymap = keymap
+	cb.keyChord.Reset()
+	return cb
+}
+
+func (cb *Checkbox) Focus() {
+	cb.MarkDirty()
+	cb.focused = true
+}
+
+func (cb *Checkbox) Blur() {
+	cb.MarkDirty()
+	cb.focused = false
+}
+
+func (cb *Checkbox) glyph() string {
+	if cb.checked {
+		return firstNonEmpty(cb.checkedGlyph, Glyphs.CheckboxChecked)
+	}
+	return firstNonEmpty(cb.uncheckedGlyph, Glyphs.CheckboxUnchecked)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// printGlyphLabel prints a glyph followed by a space and a label, and returns the width of the glyph part.
+func printGlyphLabel(screen Screen, glyph, label string, y, width int, style tcell.Style) int {
+	_, glyphWidth := PrintWithStyle(screen, Escape(glyph), 0, y, width, AlignLeft, style)
+	if glyphWidth > 0 {
+		glyphWidth++
+	}
+	PrintWithStyle(screen, label, glyphWidth, y, width-glyphWidth, AlignLeft, style)
+	return glyphWidth
+}
+
+func (cb *Checkbox) Draw(screen Screen) {
+	width, _ := screen.Size()
+	style := cb.style
+	if cb.focused {
+		style = cb.focusedStyle
+	}
+	screen.SetStyle(style)
+	screen.Clear()
+	printGlyphLabel(screen, cb.glyph(), cb.label, 0, width, style)
+}
+
+// Submit toggles the checkbox. It's called by Form when Enter is pressed.
+func (cb *Checkbox) Submit(event KeyEvent) bool {
+	cb.Toggle()
+	return true
+}
+
+func (cb *Checkbox) OnKeyEvent(event KeyEvent) bool {
+	keymap := cb.keymap
+	if keymap == nil {
+		keymap = CheckboxKeymap
+	}
+	action, consumed := keymap.Process(&cb.keyChord, event)
+	if action == "toggle" {
+		cb.Toggle()
+	}
+	return consumed
+}
+
+func (cb *Checkbox) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() == tcell.Button1 && !event.HasMotion() {
+		cb.Toggle()
+		return true
+	}
+	return false
+}
+
+func (cb *Checkbox) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
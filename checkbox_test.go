@@ -0,0 +1,57 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestCheckbox_Toggle(t *testing.T) {
+	checkbox := NewCheckbox("Enable")
+	var states []bool
+	checkbox.SetChangedFunc(func(checked bool) {
+		states = append(states, checked)
+	})
+	checkbox.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone))
+	checkbox.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone), false})
+	checkbox.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	if !slices.Equal(states, []bool{true, false, true}) || !checkbox.IsChecked() {
+		t.Errorf("expected Space, clicks and Enter to toggle the checkbox, got %v", states)
+	}
+	if checkbox.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone)) {
+		t.Errorf("expected other keys not to be handled")
+	}
+	checkbox.SetChecked(false)
+	if checkbox.IsChecked() || len(states) != 3 {
+		t.Errorf("expected SetChecked to change the state without calling the changed function")
+	}
+}
+
+func TestCheckbox_SubmitInForm(t *testing.T) {
+	checkbox := NewCheckbox("Enable")
+	next := NewInputField()
+	form := NewForm().AddFormItem(checkbox, 0, 0, 1, 1).AddFormItem(next, 0, 1, 1, 1)
+	form.FocusItem(checkbox)
+	form.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	if !checkbox.IsChecked() || form.GetFocused() != next {
+		t.Errorf("expected Enter to toggle the checkbox and focus the next form item")
+	}
+}
+
+func TestGolden_Checkbox(t *testing.T) {
+	checked := NewCheckbox("[green]Checked[-]").SetChecked(true)
+	checked.Focus()
+	unchecked := NewCheckbox("Unchecked").SetGlyphs("", "( )")
+	flex := NewFlex().SetDirection(FlexRow).
+		AddFixedComponent(checked, 1).
+		AddFixedComponent(unchecked, 1)
+	assertGolden(t, goldenPath("checkbox"), flex, 14, 2)
+}
@@ -0,0 +1,81 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+// Container is implemented by components that contain other components.
+// All built-in containers (Grid, Flex, Form, Box, Pages, Tabs, ContextMenu,
+// Centerer and FractionalCenterer) implement it.
+type Container interface {
+	Component
+	// Children returns the direct child components of the container.
+	Children() []Component
+	// ChildArea returns the area of the given child relative to the container as of the last draw.
+	// The second return value is false if the component isn't a child of the container.
+	ChildArea(child Component) (Rect, bool)
+}
+
+var (
+	_ Container = (*Grid)(nil)
+	_ Container = (*Flex)(nil)
+	_ Container = (*Form)(nil)
+	_ Container = (*Box)(nil)
+	_ Container = (*Pages)(nil)
+	_ Container = (*Tabs)(nil)
+	_ Container = (*ContextMenu)(nil)
+	_ Container = (*Centerer)(nil)
+	_ Container = (*FractionalCenterer)(nil)
+)
+
+// WalkFunc is called by Walk for each component in the tree. The area is relative to the root of the walk.
+// If the function returns false, the children of the component are skipped.
+type WalkFunc func(comp Component, parent Container, area Rect) bool
+
+// Walk calls the given function for the root component and all of its descendants in depth-first order.
+// The area of the root is assumed to start at (0, 0) and have the given size.
+func Walk(root Component, width, height int, fn WalkFunc) {
+	walk(root, nil, Rect{Width: width, Height: height}, fn)
+}
+
+func walk(comp Component, parent Container, area Rect, fn WalkFunc) {
+	if comp == nil || !fn(comp, parent, area) {
+		return
+	}
+	container, ok := comp.(Container)
+	if !ok {
+		return
+	}
+	for _, child := range container.Children() {
+		childArea, _ := container.ChildArea(child)
+		childArea.X += area.X
+		childArea.Y += area.Y
+		walk(child, container, childArea, fn)
+	}
+}
+
+// ComponentsAt returns the path of components that contain the given point, starting from the root.
+// Children that are later in the list of their container's children are preferred when they overlap.
+func ComponentsAt(root Component, width, height, x, y int) []Component {
+	var path []Component
+	Walk(root, width, height, func(comp Component, parent Container, area Rect) bool {
+		if !area.Contains(x, y) {
+			return false
+		}
+		if parent != nil {
+			// Drop any previously matched sibling subtree, so that the topmost overlapping child wins.
+			for i, pathComp := range path {
+				if pathComp == parent {
+					path = path[:i+1]
+					break
+				}
+			}
+		}
+		path = append(path, comp)
+		return true
+	})
+	return path
+}
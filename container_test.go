@@ -0,0 +1,108 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+)
+
+type walkedComponent struct {
+	comp   Component
+	parent Container
+	area   Rect
+}
+
+// containerTestTree is a grid with a flex, a box and a centerer nested in it, and a text view that overlaps
+// the lower half of the centerer.
+type containerTestTree struct {
+	grid     *Grid
+	flex     *Flex
+	box      *Box
+	boxText  *TextView
+	flexText *TextView
+	center   *Centerer
+	button   *Button
+	overlay  *TextView
+}
+
+func newContainerTestTree() *containerTestTree {
+	tree := &containerTestTree{
+		boxText:  NewTextView(),
+		flexText: NewTextView(),
+		button:   NewButton("OK"),
+		overlay:  NewTextView(),
+	}
+	tree.box = NewBox(tree.boxText)
+	tree.flex = NewFlex().SetDirection(FlexRow).
+		AddFixedComponent(tree.box, 4).
+		AddProportionalComponent(tree.flexText, 1)
+	tree.center = Center(tree.button, 4, 2)
+	tree.grid = NewGrid().SetColumns([]int{-1, -1}).SetRows([]int{-1}).
+		AddComponent(tree.flex, 0, 0, 1, 1).
+		AddComponent(tree.center, 1, 0, 1, 1).
+		AddComponent(tree.overlay, 1, 0, 1, 1).
+		SetComponentPadding(tree.overlay, 5, 0, 0, 0)
+	RenderSnapshot(tree.grid, 20, 10)
+	return tree
+}
+
+func TestWalk(t *testing.T) {
+	tree := newContainerTestTree()
+	var walked []walkedComponent
+	Walk(tree.grid, 20, 10, func(comp Component, parent Container, area Rect) bool {
+		walked = append(walked, walkedComponent{comp, parent, area})
+		return true
+	})
+	expected := []walkedComponent{
+		{tree.grid, nil, Rect{0, 0, 20, 10}},
+		{tree.flex, tree.grid, Rect{0, 0, 10, 10}},
+		{tree.box, tree.flex, Rect{0, 0, 10, 4}},
+		{tree.boxText, tree.box, Rect{1, 1, 8, 2}},
+		{tree.flexText, tree.flex, Rect{0, 4, 10, 6}},
+		{tree.center, tree.grid, Rect{10, 0, 10, 10}},
+		{tree.button, tree.center, Rect{13, 4, 4, 2}},
+		{tree.overlay, tree.grid, Rect{10, 5, 10, 5}},
+	}
+	if !slices.Equal(walked, expected) {
+		t.Errorf("expected Walk to visit\n%v\ngot\n%v", expected, walked)
+	}
+
+	walked = nil
+	Walk(tree.grid, 20, 10, func(comp Component, parent Container, area Rect) bool {
+		walked = append(walked, walkedComponent{comp, parent, area})
+		return comp != tree.flex
+	})
+	if len(walked) != 5 || walked[1].comp != tree.flex || walked[2].comp != tree.center {
+		t.Errorf("expected returning false to skip the children of the flex, got %v", walked)
+	}
+}
+
+func TestComponentsAt(t *testing.T) {
+	tree := newContainerTestTree()
+	for _, test := range []struct {
+		x, y     int
+		expected []Component
+	}{
+		{2, 2, []Component{tree.grid, tree.flex, tree.box, tree.boxText}},
+		{0, 0, []Component{tree.grid, tree.flex, tree.box}},
+		{5, 7, []Component{tree.grid, tree.flex, tree.flexText}},
+		{11, 1, []Component{tree.grid, tree.center}},
+		{14, 4, []Component{tree.grid, tree.center, tree.button}},
+		// The overlay is added after the centerer, so it wins where they overlap.
+		{14, 5, []Component{tree.grid, tree.overlay}},
+		{19, 9, []Component{tree.grid, tree.overlay}},
+		{20, 0, nil},
+		{-1, 3, nil},
+		{5, 10, nil},
+	} {
+		if path := ComponentsAt(tree.grid, 20, 10, test.x, test.y); !slices.Equal(path, test.expected) {
+			t.Errorf("expected path %v at %d,%d, got %v", test.expected, test.x, test.y, path)
+		}
+	}
+}
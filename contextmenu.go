@@ -0,0 +1,165 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// ContextMenu wraps a component and shows a Menu at the mouse position when the component is right-clicked.
+// All other events are passed to the wrapped component.
+//
+// Like DropDown, the menu is drawn in a layer above everything else, so the context menu needs a reference to
+// the application (see SetApplication). The open menu uses MenuKeymap.
+type ContextMenu struct {
+	target  Component
+	screen  *ProxyScreen
+	app     *Application
+	menu    *Menu
+	buttons tcell.ButtonMask
+	styles  MenuStyles
+	popup   *menuPopup
+	focused bool
+	// The area of the wrapped component on the root screen during the last draw.
+	area Rect
+}
+
+// NewContextMenu returns a context menu that shows the given menu when the target component is right-clicked.
+func NewContextMenu(target Component, menu *Menu) *ContextMenu {
+	return &ContextMenu{
+		target:  target,
+		screen:  &ProxyScreen{Style: tcell.StyleDefault},
+		menu:    menu,
+		buttons: tcell.Button2,
+		styles:  DefaultMenuStyles(),
+	}
+}
+
+// SetApplication sets the application whose layer stack the menu is shown in.
+func (cm *ContextMenu) SetApplication(app *Application) *ContextMenu {
+	cm.app = app
+	return cm
+}
+
+// SetMenu changes the menu that's shown. Menus can also be modified in place, e.g. based on what was clicked.
+func (cm *ContextMenu) SetMenu(menu *Menu) *ContextMenu {
+	cm.menu = menu
+	return cm
+}
+
+func (cm *ContextMenu) GetMenu() *Menu {
+	return cm.menu
+}
+
+// SetTriggerButtons sets the mouse buttons that open the menu. The default is tcell.Button2, which is the
+// secondary (usually right) button. Some terminals report the right button as tcell.Button3.
+func (cm *ContextMenu) SetTriggerButtons(buttons tcell.ButtonMask) *ContextMenu {
+	cm.buttons = buttons
+	return cm
+}
+
+// SetMenuStyles sets the styles of the open menu.
+func (cm *ContextMenu) SetMenuStyles(styles MenuStyles) *ContextMenu {
+	cm.styles = styles
+	return cm
+}
+
+// IsOpen returns true if the menu is currently open.
+func (cm *ContextMenu) IsOpen() bool {
+	return cm.popup != nil
+}
+
+// Show opens the menu with its top-left corner at the given position on the root screen, e.g. to open it with
+// the keyboard. It does nothing if the application or menu hasn't been set, or if the menu is already open.
+func (cm *ContextMenu) Show(x, y int) {
+	if cm.app == nil || cm.menu == nil || cm.popup != nil {
+		return
+	}
+	cm.popup = newMenuPopup(cm.app, cm.menu, x, y, cm.styles)
+	cm.popup.closed = func() {
+		cm.popup = nil
+	}
+	cm.app.AddLayer(cm.popup.layer)
+}
+
+// Close closes the menu without activating anything.
+func (cm *ContextMenu) Close() {
+	if cm.popup != nil {
+		cm.popup.close()
+	}
+}
+
+func (cm *ContextMenu) drawsPartially() {}
+
+func (cm *ContextMenu) Draw(screen Screen) {
+	cm.area = absoluteArea(screen)
+	cm.screen.Parent = screen
+	cm.screen.Width, cm.screen.Height = screen.Size()
+	drawChild(cm.target, cm.screen, isPartialDraw(screen), proxyStyle(screen))
+}
+
+func (cm *ContextMenu) OnKeyEvent(event KeyEvent) bool {
+	return cm.target.OnKeyEvent(event)
+}
+
+func (cm *ContextMenu) OnPasteEvent(event PasteEvent) bool {
+	return cm.target.OnPasteEvent(event)
+}
+
+func (cm *ContextMenu) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() == cm.buttons && !event.HasMotion() {
+		x, y := event.Position()
+		cm.Show(cm.area.X+x, cm.area.Y+y)
+		return true
+	}
+	return cm.target.OnMouseEvent(event)
+}
+
+func (cm *ContextMenu) Focus() {
+	cm.focused = true
+	if focusable, ok := cm.target.(Focusable); ok {
+		focusable.Focus()
+	}
+}
+
+func (cm *ContextMenu) Blur() {
+	cm.focused = false
+	if focusable, ok := cm.target.(Focusable); ok {
+		focusable.Blur()
+	}
+}
+
+// Children returns the wrapped component.
+func (cm *ContextMenu) Children() []Component {
+	return []Component{cm.target}
+}
+
+func (cm *ContextMenu) focusedChild() Component {
+	if cm.focused {
+		return cm.target
+	}
+	return nil
+}
+
+func (cm *ContextMenu) focusChild(comp Component) bool {
+	if comp != cm.target {
+		return false
+	}
+	if !cm.focused {
+		cm.Focus()
+	}
+	return true
+}
+
+// ChildArea returns the area of the wrapped component, which covers the whole context menu.
+func (cm *ContextMenu) ChildArea(comp Component) (Rect, bool) {
+	if comp != cm.target {
+		return Rect{}, false
+	}
+	return cm.screen.area(), true
+}
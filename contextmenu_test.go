@@ -0,0 +1,57 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestContextMenu(t *testing.T) {
+	var activated []string
+	menu := NewMenu("", 0).
+		AddItem("Reply", 'r', func() { activated = append(activated, "reply") }).
+		AddItem("Delete", 'd', func() { activated = append(activated, "delete") })
+	list := newTestList(3).ShowSecondaryText(false)
+	contextMenu := NewContextMenu(list, menu)
+	root := NewFlex().SetDirection(FlexRow).
+		AddFixedComponent(NewTextView().SetText("header"), 1).
+		AddProportionalComponent(contextMenu, 1)
+	sim := startSimulation(t, root, 20, 8)
+	contextMenu.SetApplication(sim.App)
+
+	// The menu opens at the position of the right click on the root screen.
+	sim.InjectMouse(10, 2, tcell.Button2, tcell.ModNone).InjectMouse(10, 2, tcell.ButtonNone, tcell.ModNone)
+	waitForDraw(t, sim)
+	if !contextMenu.IsOpen() {
+		t.Fatalf("expected right-clicking to open the context menu")
+	}
+	if line := sim.Lines()[3]; !strings.HasSuffix(line, "│ Reply  │") {
+		t.Errorf("expected the menu to open at the click, got %q", line)
+	}
+	sim.InjectKey(tcell.KeyDown, 0, tcell.ModNone).InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if contextMenu.IsOpen() || !slices.Equal(activated, []string{"delete"}) {
+		t.Errorf("expected Enter to activate the item and close the menu, got %v", activated)
+	}
+
+	// Clicking outside closes the menu and the click goes through to the component below.
+	sim.InjectMouse(10, 2, tcell.Button2, tcell.ModNone).InjectMouse(10, 2, tcell.ButtonNone, tcell.ModNone)
+	waitForDraw(t, sim)
+	sim.InjectClick(1, 3)
+	waitForDraw(t, sim)
+	if contextMenu.IsOpen() || len(activated) != 1 {
+		t.Errorf("expected clicking outside to close the menu without activating anything")
+	}
+	if current := list.GetCurrentItem(); current != 2 {
+		t.Errorf("expected the click outside the menu to reach the list, current item is %d", current)
+	}
+}
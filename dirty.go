@@ -0,0 +1,92 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"sync/atomic"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Dirtyable is implemented by components that know whether they need to be redrawn.
+//
+// When the application isn't set to always clear the screen, redraws are partial: the built-in containers keep
+// the previous frame and skip drawing children that implement Dirtyable and aren't dirty. Components that don't
+// implement Dirtyable are always redrawn. Components that show the cursor shouldn't implement this, as the cursor
+// is hidden before every draw.
+type Dirtyable interface {
+	IsDirty() bool
+	MarkDirty()
+	MarkClean()
+}
+
+// DirtyTracker is a thread-safe implementation of Dirtyable that can be embedded in components.
+// The zero value is dirty, so components are always drawn at least once.
+type DirtyTracker struct {
+	clean atomic.Bool
+}
+
+var _ Dirtyable = (*DirtyTracker)(nil)
+
+// IsDirty returns true if the component has changed since it was last drawn.
+func (dt *DirtyTracker) IsDirty() bool {
+	return !dt.clean.Load()
+}
+
+// MarkDirty marks the component as changed, so that it's redrawn in the next partial redraw.
+func (dt *DirtyTracker) MarkDirty() {
+	dt.clean.Store(false)
+}
+
+// MarkClean marks the component as drawn. This is called by containers right before drawing the component.
+func (dt *DirtyTracker) MarkClean() {
+	dt.clean.Store(true)
+}
+
+// partialDrawer is implemented by containers that handle partial redraws of their children themselves.
+// Such containers are never cleared or skipped by their parent during a partial redraw.
+type partialDrawer interface {
+	drawsPartially()
+}
+
+func isPartialDraw(screen Screen) bool {
+	proxy, ok := screen.(*ProxyScreen)
+	return ok && proxy.partial
+}
+
+// proxyStyle returns the style the given screen would be cleared with.
+func proxyStyle(screen Screen) tcell.Style {
+	if proxy, ok := screen.(*ProxyScreen); ok {
+		return proxy.Style
+	}
+	return tcell.StyleDefault
+}
+
+// drawChild draws a child component of a container onto the given screen.
+//
+// If partial is true, the area of the screen is expected to still contain what was drawn in the previous frame.
+// Clean Dirtyable children are skipped, and the area of other children is cleared with the given style first,
+// unless the child is a container that handles partial redraws itself. Returns true if the child was drawn.
+func drawChild(target Component, screen *ProxyScreen, partial bool, clearStyle tcell.Style) bool {
+	screen.partial = partial
+	dirtyable, isDirtyable := target.(Dirtyable)
+	if partial {
+		if _, isPartialDrawer := target.(partialDrawer); !isPartialDrawer {
+			if isDirtyable && !dirtyable.IsDirty() {
+				return false
+			}
+			screen.partial = false
+			screen.Fill(' ', clearStyle)
+		}
+	}
+	if isDirtyable {
+		dirtyable.MarkClean()
+	}
+	target.Draw(screen)
+	return true
+}
@@ -0,0 +1,94 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+type drawCounter struct {
+	DirtyTracker
+	SimpleEventHandler
+	draws int
+}
+
+func (dc *drawCounter) Draw(screen Screen) {
+	dc.draws++
+	screen.SetContent(0, 0, 'x', nil, tcell.StyleDefault)
+}
+
+func newDrawTestApp(t testing.TB, root Component, width, height int) (*Application, tcell.SimulationScreen) {
+	t.Helper()
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("failed to initialize screen: %v", err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(width, height)
+	app := NewApplication()
+	app.SetRoot(root)
+	return app, screen
+}
+
+func TestPartialDraw_SkipsCleanComponents(t *testing.T) {
+	first, second := &drawCounter{}, &drawCounter{}
+	grid := NewGrid().SetColumns([]int{-1, -1}).AddComponent(first, 0, 0, 1, 1).AddComponent(second, 1, 0, 1, 1)
+	app, screen := newDrawTestApp(t, grid, 10, 1)
+	app.SetAlwaysClear(false)
+
+	app.draw(screen, false)
+	app.draw(screen, false)
+	if first.draws != 1 || second.draws != 1 {
+		t.Fatalf("expected clean components to be drawn once, got %d and %d draws", first.draws, second.draws)
+	}
+	second.MarkDirty()
+	app.draw(screen, false)
+	if first.draws != 1 || second.draws != 2 {
+		t.Errorf("expected only the dirty component to be redrawn, got %d and %d draws", first.draws, second.draws)
+	}
+	app.draw(screen, true)
+	if first.draws != 2 || second.draws != 3 {
+		t.Errorf("expected a full redraw to draw everything, got %d and %d draws", first.draws, second.draws)
+	}
+}
+
+// BenchmarkDraw measures a redraw where only one of many text views has changed,
+// which is the common case of e.g. a new message arriving in a chat client.
+func BenchmarkDraw(b *testing.B) {
+	for _, alwaysClear := range []bool{true, false} {
+		name := "Partial"
+		if alwaysClear {
+			name = "AlwaysClear"
+		}
+		b.Run(name, func(b *testing.B) {
+			const columns, rows = 4, 12
+			grid := NewGrid()
+			views := make([]*TextView, 0, columns*rows)
+			for x := 0; x < columns; x++ {
+				for y := 0; y < rows; y++ {
+					view := NewTextView().SetText(strings.Repeat(fmt.Sprintf("cell %d,%d ", x, y), 10))
+					view.SetWrap(true)
+					grid.AddComponent(view, x, y, 1, 1)
+					views = append(views, view)
+				}
+			}
+			app, screen := newDrawTestApp(b, grid, 160, 48)
+			app.SetAlwaysClear(alwaysClear)
+			app.draw(screen, true)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				views[i%len(views)].SetText(fmt.Sprintf("changed %d", i))
+				app.draw(screen, false)
+			}
+		})
+	}
+}
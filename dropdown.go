@@ -0,0 +1,425 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// DropDown is a single-line form item that shows the current option and opens a popup list of all options.
+//
+// The popup is drawn in a layer above everything else, so the drop-down needs a reference to the application
+// (see SetApplication). Without one, the options can still be changed with the arrow keys and by typing.
+//
+// While the drop-down is closed, Up and Down switch to the previous and next option, and typing selects the first
+// option that starts with the typed text. Inside a Form, Enter opens the popup and Tab moves to the next item.
+// In the popup, the keys in ListKeymap move the cursor, Enter or a click selects an option, typing jumps to the
+// first matching option and Escape or a click outside closes the popup without changing anything.
+type DropDown struct {
+	DirtyTracker
+
+	app      *Application
+	options  []string
+	current  int
+	focused  bool
+	popup    *dropDownPopup
+	selected func(index int, option string)
+
+	placeholder    string
+	style          tcell.Style
+	focusedStyle   tcell.Style
+	popupStyle     tcell.Style
+	arrowGlyph     string
+	maxPopupHeight int
+
+	// The area of the drop-down on the root screen during the last draw, used to position the popup.
+	area Rect
+
+	searchPrefix   string
+	lastSearchTime time.Time
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// DropDownKeymap is the default keymap of closed drop-downs. It can be overridden per drop-down with SetKeymap.
+// The popup uses ListKeymap.
+var DropDownKeymap = newDefaultKeymap("drop-down", []string{
+	"open", "previous-option", "next-option",
+}, map[string]string{
+	"Enter":    "open",
+	"Space":    "open",
+	"Alt+Down": "open",
+	"Up":       "previous-option",
+	"Down":     "next-option",
+})
+
+// NewDropDown returns a new drop-down with the given options. Nothing is selected by default.
+func NewDropDown(options ...string) *DropDown {
+	return &DropDown{
+		options:        options,
+		current:        -1,
+		style:          tcell.StyleDefault.Background(Styles.ContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		focusedStyle:   tcell.StyleDefault.Background(Styles.MoreContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		popupStyle:     tcell.StyleDefault.Background(Styles.ContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		maxPopupHeight: 10,
+	}
+}
+
+// SetApplication sets the application whose layer stack the popup is shown in.
+func (dd *DropDown) SetApplication(app *Application) *DropDown {
+	dd.app = app
+	return dd
+}
+
+// SetOptions replaces the options of the drop-down. The current option is cleared if it's out of range.
+func (dd *DropDown) SetOptions(options ...string) *DropDown {
+	dd.MarkDirty()
+	dd.options = options
+	if dd.current >= len(options) {
+		dd.current = -1
+	}
+	return dd
+}
+
+// AddOption adds an option to the end of the drop-down.
+func (dd *DropDown) AddOption(option string) *DropDown {
+	dd.MarkDirty()
+	dd.options = append(dd.options, option)
+	return dd
+}
+
+func (dd *DropDown) GetOptions() []string {
+	return dd.options
+}
+
+// SetCurrentOption sets the current option without calling the selected function. -1 clears the selection.
+func (dd *DropDown) SetCurrentOption(index int) *DropDown {
+	if index < -1 || index >= len(dd.options) {
+		return dd
+	}
+	dd.MarkDirty()
+	dd.current = index
+	return dd
+}
+
+// GetCurrentOption returns the index and text of the current option, or -1 and an empty string if nothing is selected.
+func (dd *DropDown) GetCurrentOption() (int, string) {
+	if dd.current < 0 {
+		return -1, ""
+	}
+	return dd.current, dd.options[dd.current]
+}
+
+// Select makes the option at the given index current and calls the selected function.
+func (dd *DropDown) Select(index int) *DropDown {
+	if index < 0 || index >= len(dd.options) {
+		return dd
+	}
+	dd.SetCurrentOption(index)
+	if dd.selected != nil {
+		dd.selected(index, dd.options[index])
+	}
+	return dd
+}
+
+// SetSelectedFunc sets a function which is called when the user selects an option.
+func (dd *DropDown) SetSelectedFunc(handler func(index int, option string)) *DropDown {
+	dd.selected = handler
+	return dd
+}
+
+// SetPlaceholder sets the text shown when nothing is selected. Color tags are supported.
+func (dd *DropDown) SetPlaceholder(placeholder string) *DropDown {
+	dd.MarkDirty()
+	dd.placeholder = placeholder
+	return dd
+}
+
+func (dd *DropDown) SetStyle(style tcell.Style) *DropDown {
+	dd.MarkDirty()
+	dd.style = style
+	return dd
+}
+
+func (dd *DropDown) SetFocusedStyle(style tcell.Style) *DropDown {
+	dd.MarkDirty()
+	dd.focusedStyle = style
+	return dd
+}
+
+// SetPopupStyle sets the style of the option list in the popup. The current option is drawn in reverse.
+func (dd *DropDown) SetPopupStyle(style tcell.Style) *DropDown {
+	dd.popupStyle = style
+	return dd
+}
+
+// SetArrowGlyph sets the glyph drawn at the end of the drop-down. An empty string uses the default in Glyphs.
+func (dd *DropDown) SetArrowGlyph(glyph string) *DropDown {
+	dd.MarkDirty()
+	dd.arrowGlyph = glyph
+	return dd
+}
+
+// SetMaxPopupHeight sets the maximum number of options visible in the popup at once.
+func (dd *DropDown) SetMaxPopupHeight(height int) *DropDown {
+	dd.maxPopupHeight = max(height, 1)
+	return dd
+}
+
+// SetKeymap sets the keymap used by this drop-down while it's closed. If nil, DropDownKeymap is used.
+func (dd *DropDown) SetKeymap(keymap *Keymap) *DropDown {
+	dd.keymap = keymap
+	dd.keyChord.Reset()
+	return dd
+}
+
+// IsOpen returns true if the popup is currently open.
+func (dd *DropDown) IsOpen() bool {
+	return dd.popup != nil
+}
+
+// Open opens the popup. It does nothing if the application hasn't been set or there are no options.
+func (dd *DropDown) Open() *DropDown {
+	if dd.app == nil || dd.popup != nil || len(dd.options) == 0 {
+		return dd
+	}
+	dd.MarkDirty()
+	dd.searchPrefix = ""
+	dd.popup = newDropDownPopup(dd)
+	dd.app.AddLayer(dd.popup.layer)
+	return dd
+}
+
+// Close closes the popup without changing the current option.
+func (dd *DropDown) Close() *DropDown {
+	if dd.popup == nil {
+		return dd
+	}
+	dd.MarkDirty()
+	dd.searchPrefix = ""
+	dd.app.RemoveLayer(dd.popup.layer)
+	dd.popup = nil
+	return dd
+}
+
+// search finds the first option that starts with the text typed within ChordTimeout of each other.
+// Typing the same letter repeatedly cycles through the options starting with that letter.
+func (dd *DropDown) search(char rune, from int) int {
+	if time.Since(dd.lastSearchTime) > ChordTimeout {
+		dd.searchPrefix = ""
+	}
+	dd.lastSearchTime = time.Now()
+	dd.searchPrefix += strings.ToLower(string(char))
+	prefix, start := dd.searchPrefix, 0
+	if first := strings.ToLower(string(char)); strings.Count(prefix, first) == utf8.RuneCountInString(prefix) {
+		prefix, start = first, from+1
+	}
+	for i := range dd.options {
+		index := (start + i) % len(dd.options)
+		if strings.HasPrefix(strings.ToLower(stripTags(dd.options[index])), prefix) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (dd *DropDown) Focus() {
+	dd.MarkDirty()
+	dd.focused = true
+}
+
+func (dd *DropDown) Blur() {
+	dd.MarkDirty()
+	dd.focused = false
+	dd.Close()
+}
+
+func (dd *DropDown) Draw(screen Screen) {
+	width, _ := screen.Size()
+	dd.area = absoluteArea(screen)
+	style := dd.style
+	if dd.focused {
+		style = dd.focusedStyle
+	}
+	screen.SetStyle(style)
+	screen.Clear()
+	arrow := firstNonEmpty(dd.arrowGlyph, Glyphs.DropDownArrow)
+	arrowWidth := TaggedStringWidth(Escape(arrow))
+	PrintWithStyle(screen, Escape(arrow), width-arrowWidth, 0, arrowWidth, AlignLeft, style)
+	text := dd.placeholder
+	if dd.current >= 0 {
+		text = dd.options[dd.current]
+	}
+	PrintWithStyle(screen, text, 0, 0, width-arrowWidth-1, AlignLeft, style)
+}
+
+// Submit opens the popup. It's called by Form when Enter is pressed.
+// If the popup can't be opened, the form moves to the next item instead.
+func (dd *DropDown) Submit(event KeyEvent) bool {
+	dd.Open()
+	return dd.popup == nil
+}
+
+func (dd *DropDown) OnKeyEvent(event KeyEvent) bool {
+	keymap := dd.keymap
+	if keymap == nil {
+		keymap = DropDownKeymap
+	}
+	action, consumed := keymap.Process(&dd.keyChord, event)
+	switch action {
+	case "open":
+		dd.Open()
+	case "previous-option":
+		if dd.current > 0 {
+			dd.Select(dd.current - 1)
+		}
+	case "next-option":
+		if dd.current < len(dd.options)-1 {
+			dd.Select(dd.current + 1)
+		}
+	case "":
+		if !consumed && event.Key() == tcell.KeyRune && len(dd.options) > 0 {
+			if index := dd.search(event.Rune(), dd.current); index >= 0 {
+				dd.Select(index)
+			}
+			return true
+		}
+	}
+	return consumed
+}
+
+func (dd *DropDown) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() == tcell.Button1 && !event.HasMotion() {
+		if dd.popup != nil {
+			dd.Close()
+		} else {
+			dd.Open()
+		}
+		return true
+	}
+	return false
+}
+
+func (dd *DropDown) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
+
+// dropDownPopup is the option list of an open DropDown. It covers the whole screen in a non-modal layer,
+// so it can close itself when something outside it is clicked while still letting that click through.
+type dropDownPopup struct {
+	dropDown *DropDown
+	list     *List
+	layer    *Layer
+	screen   *ProxyScreen
+}
+
+func newDropDownPopup(dd *DropDown) *dropDownPopup {
+	popup := &dropDownPopup{
+		dropDown: dd,
+		screen:   &ProxyScreen{Style: dd.popupStyle},
+	}
+	fg, bg, _ := dd.popupStyle.Decompose()
+	popup.list = NewList().
+		ShowSecondaryText(false).
+		SetBackgroundColor(bg).
+		SetMainTextColor(fg).
+		SetSelectedTextColor(bg).
+		SetSelectedBackgroundColor(fg)
+	for _, option := range dd.options {
+		popup.list.AddItem(option, "", 0, nil)
+	}
+	if dd.current >= 0 {
+		popup.list.SetCurrentItem(dd.current)
+	}
+	popup.list.SetSelectedFunc(func(index int, _ *ListItem) {
+		dd.Close()
+		dd.Select(index)
+	})
+	popup.list.Focus()
+	popup.layer = NewLayer(popup).SetZIndex(PopupZIndex)
+	return popup
+}
+
+// PopupZIndex is the z-index of the layers used by popups like the option list of DropDown.
+var PopupZIndex = 100
+
+// isClosed returns true if the drop-down has closed this popup. Removing the layer goes through the application's
+// update queue, so a closed popup may still receive a few events or draws before it's actually gone.
+func (popup *dropDownPopup) isClosed() bool {
+	return popup.dropDown.popup != popup
+}
+
+func (popup *dropDownPopup) Draw(screen Screen) {
+	if popup.isClosed() {
+		return
+	}
+	screenWidth, screenHeight := screen.Size()
+	area := popup.dropDown.area
+	height := min(len(popup.dropDown.options), popup.dropDown.maxPopupHeight)
+	y := area.Y + 1
+	if y+height > screenHeight && area.Y-height >= 0 {
+		// Not enough space below the drop-down, so open upwards.
+		y = area.Y - height
+	}
+	height = min(height, screenHeight-y)
+	popup.screen.Parent = screen
+	popup.screen.OffsetX = max(0, min(area.X, screenWidth-area.Width))
+	popup.screen.OffsetY = y
+	popup.screen.Width = min(area.Width, screenWidth)
+	popup.screen.Height = height
+	popup.list.Draw(popup.screen)
+}
+
+func (popup *dropDownPopup) OnKeyEvent(event KeyEvent) bool {
+	if popup.isClosed() {
+		return false
+	}
+	switch event.Key() {
+	case tcell.KeyEscape:
+		popup.dropDown.Close()
+		return true
+	case tcell.KeyTab, tcell.KeyBacktab:
+		// Let the key through so that it moves the focus onwards.
+		popup.dropDown.Close()
+		return false
+	case tcell.KeyRune:
+		if event.Modifiers()&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+			if index := popup.dropDown.search(event.Rune(), popup.list.GetCurrentItem()); index >= 0 {
+				popup.list.SetCurrentItem(index)
+			}
+			return true
+		}
+	}
+	popup.list.OnKeyEvent(event)
+	// The popup captures all other keys while it's open.
+	return true
+}
+
+func (popup *dropDownPopup) OnMouseEvent(event MouseEvent) bool {
+	if popup.isClosed() {
+		return false
+	} else if popup.screen.area().Contains(event.Position()) {
+		return popup.list.OnMouseEvent(popup.screen.OffsetMouseEvent(event))
+	} else if event.Buttons() != tcell.ButtonNone && !event.HasMotion() {
+		popup.dropDown.Close()
+		// Clicking the drop-down itself should only close the popup rather than reopening it.
+		if popup.dropDown.area.Contains(event.Position()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (popup *dropDownPopup) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
@@ -0,0 +1,121 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestDropDown_ClosedKeys(t *testing.T) {
+	dropDown := NewDropDown("apple", "banana", "blueberry", "cherry")
+	var selections []string
+	dropDown.SetSelectedFunc(func(index int, option string) {
+		selections = append(selections, option)
+	})
+	if index, _ := dropDown.GetCurrentOption(); index != -1 {
+		t.Errorf("expected nothing to be selected by default, got %d", index)
+	}
+	key := func(key tcell.Key, r rune) {
+		dropDown.OnKeyEvent(tcell.NewEventKey(key, r, tcell.ModNone))
+	}
+	key(tcell.KeyDown, 0)
+	key(tcell.KeyUp, 0)
+	// Typing the same letter again cycles through the options starting with it.
+	key(tcell.KeyRune, 'b')
+	key(tcell.KeyRune, 'b')
+	key(tcell.KeyRune, 'b')
+	key(tcell.KeyRune, 'x')
+	key(tcell.KeyEnd, 0)
+	expected := []string{"apple", "banana", "blueberry", "banana"}
+	if !slices.Equal(selections, expected) {
+		t.Errorf("expected selections %v, got %v", expected, selections)
+	}
+	// Without an application, the popup can't be opened.
+	key(tcell.KeyEnter, 0)
+	if dropDown.IsOpen() {
+		t.Errorf("expected the popup not to open without an application")
+	}
+}
+
+func TestDropDown_Popup(t *testing.T) {
+	dropDown := NewDropDown("apple", "banana", "blueberry", "cherry").SetPlaceholder("pick")
+	var selections []string
+	dropDown.SetSelectedFunc(func(index int, option string) {
+		selections = append(selections, option)
+	})
+	field := NewInputField()
+	form := NewForm().
+		AddFormItem(dropDown, 0, 0, 1, 1).
+		AddFormItem(field, 0, 1, 1, 1)
+	form.SetRows([]int{1, 1, -1})
+	form.FocusItem(dropDown)
+	sim := startSimulation(t, form, 12, 6)
+	dropDown.SetApplication(sim.App)
+
+	sim.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if !dropDown.IsOpen() {
+		t.Fatalf("expected Enter to open the popup")
+	}
+	assertGoldenSnapshot(t, goldenPath("dropdown_open"), sim.Snapshot())
+	sim.InjectString("bl").InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if dropDown.IsOpen() || !slices.Equal(selections, []string{"blueberry"}) {
+		t.Errorf("expected typing and Enter to select an option and close the popup, got %v", selections)
+	}
+	if line := sim.Lines()[0]; !strings.HasPrefix(line, "blueberry") {
+		t.Errorf("expected the drop-down to show the selected option, got %q", line)
+	}
+
+	// Clicking an option selects it, clicking outside the popup closes it without selecting anything.
+	sim.InjectClick(3, 0)
+	waitForDraw(t, sim)
+	sim.InjectClick(3, 4)
+	waitForDraw(t, sim)
+	sim.InjectClick(3, 0)
+	waitForDraw(t, sim)
+	sim.InjectClick(3, 5)
+	waitForDraw(t, sim)
+	if dropDown.IsOpen() || !slices.Equal(selections, []string{"blueberry", "cherry"}) {
+		t.Errorf("expected one option to be selected by clicking, got %v", selections)
+	}
+
+	// Tab closes the popup and moves the focus onwards.
+	sim.InjectClick(3, 0)
+	waitForDraw(t, sim)
+	sim.InjectKey(tcell.KeyTab, 0, tcell.ModNone).InjectString("x")
+	waitForDraw(t, sim)
+	if dropDown.IsOpen() || field.GetText() != "x" {
+		t.Errorf("expected Tab to close the popup and focus the next field")
+	}
+}
+
+func TestDropDown_PopupOpensUpwards(t *testing.T) {
+	dropDown := NewDropDown("one", "two", "three")
+	grid := NewGrid().SetRows([]int{-1, 1}).AddComponent(dropDown, 0, 1, 1, 1)
+	sim := startSimulation(t, grid, 8, 5)
+	dropDown.SetApplication(sim.App)
+	sim.InjectClick(0, 4)
+	waitForDraw(t, sim)
+	lines := sim.Lines()
+	if !strings.HasPrefix(lines[1], "one") || !strings.HasPrefix(lines[3], "three") {
+		t.Errorf("expected the popup to open above the drop-down, got %q", lines)
+	}
+	sim.InjectKey(tcell.KeyEscape, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if dropDown.IsOpen() {
+		t.Errorf("expected Escape to close the popup")
+	}
+	if index, _ := dropDown.GetCurrentOption(); index != -1 {
+		t.Errorf("expected closing the popup not to select anything, got %d", index)
+	}
+}
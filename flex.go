@@ -18,15 +18,53 @@ const (
 	FlexColumn
 )
 
+// FlexAlignment defines how children with a fixed cross size are positioned on the cross axis of a Flex.
+type FlexAlignment int
+
+const (
+	// FlexStretch makes children fill the cross axis regardless of their cross size.
+	FlexStretch FlexAlignment = iota
+	FlexStart
+	FlexCenter
+	FlexEnd
+)
+
 type flexChild struct {
 	genericChild
-	size int
+	size      int
+	minSize   int
+	maxSize   int
+	crossSize int
+}
+
+// clamp limits the given size to the min and max size of the child. Zero constraints are ignored.
+func (child *flexChild) clamp(size int) int {
+	if child.minSize > 0 {
+		size = max(size, child.minSize)
+	}
+	if child.maxSize > 0 {
+		size = min(size, child.maxSize)
+	}
+	return size
 }
 
 type Flex struct {
 	direction FlexDirection
 	children  []flexChild
-	focused   *flexChild
+	focused   Component
+
+	gap           int
+	paddingTop    int
+	paddingBottom int
+	paddingLeft   int
+	paddingRight  int
+	alignment     FlexAlignment
+	wrap          bool
+
+	prevWidth   int
+	prevHeight  int
+	prevFocused Component
+	forceResize bool
 }
 
 func NewFlex() *Flex {
@@ -39,6 +77,50 @@ func NewFlex() *Flex {
 
 func (flex *Flex) SetDirection(direction FlexDirection) *Flex {
 	flex.direction = direction
+	flex.forceResize = true
+	return flex
+}
+
+// SetGap sets the number of empty cells between adjacent children, and between lines in wrap mode.
+func (flex *Flex) SetGap(gap int) *Flex {
+	flex.gap = gap
+	flex.forceResize = true
+	return flex
+}
+
+// SetPadding sets the number of empty cells around the children.
+func (flex *Flex) SetPadding(top, bottom, left, right int) *Flex {
+	flex.paddingTop, flex.paddingBottom, flex.paddingLeft, flex.paddingRight = top, bottom, left, right
+	flex.forceResize = true
+	return flex
+}
+
+// SetAlignment sets how children with a cross size (see SetCrossSize) are positioned on the cross axis.
+// The default is FlexStretch, which ignores the cross size.
+func (flex *Flex) SetAlignment(alignment FlexAlignment) *Flex {
+	flex.alignment = alignment
+	flex.forceResize = true
+	return flex
+}
+
+// SetWrap sets whether children that don't fit on the main axis are moved to a new line, like CSS flex-wrap.
+//
+// In wrap mode, children are put on lines based on their fixed size, or their minimum size (at least 1) if they're
+// proportional, and the remaining space on each line is then distributed among its proportional children.
+// Each line is as thick as the largest cross size of its children, or 1 if none of them have a cross size.
+func (flex *Flex) SetWrap(wrap bool) *Flex {
+	flex.wrap = wrap
+	flex.forceResize = true
+	return flex
+}
+
+// SetCrossSize sets the size of the given child component on the cross axis, i.e. its height in a FlexColumn and
+// its width in a FlexRow. Zero means the child fills the whole cross axis.
+func (flex *Flex) SetCrossSize(comp Component, size int) *Flex {
+	if child := flex.findChild(comp); child != nil {
+		child.crossSize = size
+		flex.forceResize = true
+	}
 	return flex
 }
 
@@ -47,6 +129,12 @@ func (flex *Flex) AddFixedComponent(comp Component, size int) *Flex {
 	return flex
 }
 
+// AddAutoComponent adds a component that's sized based on its preferred size (see Sizer).
+// This is equivalent to AddFixedComponent(comp, AutoSize).
+func (flex *Flex) AddAutoComponent(comp Component) *Flex {
+	return flex.AddFixedComponent(comp, AutoSize)
+}
+
 func (flex *Flex) AddProportionalComponent(comp Component, size int) *Flex {
 	flex.children = append(flex.children, flexChild{
 		genericChild: genericChild{
@@ -55,6 +143,42 @@ func (flex *Flex) AddProportionalComponent(comp Component, size int) *Flex {
 		},
 		size: -size,
 	})
+	flex.forceResize = true
+	return flex
+}
+
+// SetFixedSize changes the size of the given child component to a fixed number of cells, e.g. to resize a sidebar.
+// The size can also be AutoSize.
+func (flex *Flex) SetFixedSize(comp Component, size int) *Flex {
+	if child := flex.findChild(comp); child != nil {
+		child.size = size
+		flex.forceResize = true
+	}
+	return flex
+}
+
+// SetProportionalSize changes the size of the given child component to a proportion of the space left over
+// after fixed-size components.
+func (flex *Flex) SetProportionalSize(comp Component, proportion int) *Flex {
+	if child := flex.findChild(comp); child != nil {
+		child.size = -proportion
+		flex.forceResize = true
+	}
+	return flex
+}
+
+// SetSizeConstraints sets the minimum and maximum size of the given child component. Zero means no constraint.
+//
+// Proportional components that would be smaller or larger than allowed are clamped, and the space they leave
+// or take is distributed among the other proportional components. Fixed-size components are clamped too.
+// If there isn't enough space for all components, they're shrunk towards their minimum size first, and then
+// further until they fit, starting from the last one. A component below its minimum size may be cut off partially
+// or collapsed to zero.
+func (flex *Flex) SetSizeConstraints(comp Component, minSize, maxSize int) *Flex {
+	if child := flex.findChild(comp); child != nil {
+		child.minSize, child.maxSize = minSize, maxSize
+		flex.forceResize = true
+	}
 	return flex
 }
 
@@ -64,92 +188,343 @@ func (flex *Flex) RemoveComponent(comp Component) *Flex {
 			flex.children = append(flex.children[:index], flex.children[index+1:]...)
 		}
 	}
+	if flex.focused == comp {
+		flex.focused = nil
+	}
+	flex.forceResize = true
 	return flex
 }
 
+func (flex *Flex) drawsPartially() {}
+
 func (flex *Flex) Draw(screen Screen) {
 	width, height := screen.Size()
-	screen.Clear()
-	relTotalSize := width
-	if flex.direction == FlexRow {
-		relTotalSize = height
+	partial := isPartialDraw(screen) && !flex.forceResize &&
+		flex.prevWidth == width && flex.prevHeight == height && flex.focused == flex.prevFocused
+	flex.prevWidth, flex.prevHeight = width, height
+	flex.prevFocused = flex.focused
+	flex.forceResize = false
+	areas := flex.layout(width, height)
+	for i, child := range flex.children {
+		// Auto-sized children can change the layout without anything else changing.
+		if child.screen.area() != areas[i] {
+			partial = false
+		}
 	}
-	relParts := 0
-	for _, child := range flex.children {
-		if child.size > 0 {
-			relTotalSize -= child.size
-		} else {
-			relParts -= child.size
+	if !partial {
+		screen.Clear()
+	}
+	clearStyle := proxyStyle(screen)
+	for i, child := range flex.children {
+		child.screen.Parent = screen
+		area := areas[i]
+		child.screen.OffsetX, child.screen.OffsetY = area.X, area.Y
+		child.screen.Width, child.screen.Height = area.Width, area.Height
+		if !area.IsEmpty() && child.target != flex.focused {
+			drawChild(child.target, child.screen, partial, clearStyle)
 		}
+	}
+	if focused := flex.findChild(flex.focused); focused != nil && !focused.screen.area().IsEmpty() {
+		drawChild(focused.target, focused.screen, partial, clearStyle)
+	}
+}
 
+// flexLine is a range of children that are laid out on the same line.
+type flexLine struct {
+	start, end int
+}
+
+// lines splits the children into lines that fit in the given main axis size. Without wrap mode,
+// all children are on the same line.
+func (flex *Flex) lines(children []flexChild, mainSize int) []flexLine {
+	if !flex.wrap {
+		return []flexLine{{0, len(children)}}
 	}
-	offset := 0
-	for _, child := range flex.children {
-		child.screen.Parent = screen
+	var lines []flexLine
+	line, used := flexLine{}, 0
+	for i := range children {
+		child := &children[i]
 		size := child.size
 		if size < 0 {
-			size = relTotalSize * (-size) / relParts
+			size = max(child.minSize, 1)
 		}
+		size = child.clamp(size)
+		if line.end > line.start {
+			size += flex.gap
+		}
+		if line.end > line.start && used+size > mainSize {
+			lines = append(lines, line)
+			line, used = flexLine{i, i}, 0
+			size -= flex.gap
+		}
+		line.end = i + 1
+		used += size
+	}
+	if line.end > line.start {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// resolveAutoSizes returns a copy of the children where AutoSize is replaced with the preferred size of the child
+// along the main axis, or a proportion of 1 if the child doesn't implement Sizer.
+func (flex *Flex) resolveAutoSizes(mainSize, crossSize int) []flexChild {
+	children := make([]flexChild, len(flex.children))
+	copy(children, flex.children)
+	for i := range children {
+		child := &children[i]
+		if child.size != AutoSize {
+			continue
+		}
+		child.size = -1
 		if flex.direction == FlexRow {
-			child.screen.Height = size
-			child.screen.Width = width
-			child.screen.OffsetY = offset
-			child.screen.OffsetX = 0
+			if _, height, ok := preferredSize(child.target, crossSize, mainSize); ok {
+				child.size = height
+			}
+		} else if width, _, ok := preferredSize(child.target, mainSize, crossSize); ok {
+			child.size = width
+		}
+	}
+	return children
+}
+
+// layout returns the areas of the children relative to the flex.
+func (flex *Flex) layout(width, height int) []Rect {
+	areas := make([]Rect, len(flex.children))
+	inner := Rect{
+		X:      flex.paddingLeft,
+		Y:      flex.paddingTop,
+		Width:  max(width-flex.paddingLeft-flex.paddingRight, 0),
+		Height: max(height-flex.paddingTop-flex.paddingBottom, 0),
+	}
+	mainSize, crossSize := inner.Width, inner.Height
+	if flex.direction == FlexRow {
+		mainSize, crossSize = crossSize, mainSize
+	}
+	allChildren := flex.resolveAutoSizes(mainSize, crossSize)
+	crossOffset := 0
+	for _, line := range flex.lines(allChildren, mainSize) {
+		children := allChildren[line.start:line.end]
+		lineCross := crossSize
+		if flex.wrap {
+			lineCross = 1
+			for _, child := range children {
+				lineCross = max(lineCross, child.crossSize)
+			}
+			lineCross = max(min(lineCross, crossSize-crossOffset), 0)
+		}
+		sizes := layoutSizes(children, max(mainSize-flex.gap*(len(children)-1), 0))
+		mainOffset := 0
+		for i, child := range children {
+			cross, crossPos := lineCross, 0
+			if child.crossSize > 0 && flex.alignment != FlexStretch {
+				cross = min(child.crossSize, lineCross)
+				switch flex.alignment {
+				case FlexCenter:
+					crossPos = (lineCross - cross) / 2
+				case FlexEnd:
+					crossPos = lineCross - cross
+				}
+			}
+			size := max(min(sizes[i], mainSize-mainOffset), 0)
+			if flex.direction == FlexRow {
+				areas[line.start+i] = Rect{
+					X: inner.X + crossOffset + crossPos, Y: inner.Y + mainOffset, Width: cross, Height: size,
+				}
+			} else {
+				areas[line.start+i] = Rect{
+					X: inner.X + mainOffset, Y: inner.Y + crossOffset + crossPos, Width: size, Height: cross,
+				}
+			}
+			mainOffset = min(mainOffset+size+flex.gap, mainSize)
+		}
+		crossOffset = min(crossOffset+lineCross+flex.gap, crossSize)
+	}
+	return areas
+}
+
+// layoutSizes returns the sizes of the given children along the main axis of the flex.
+func layoutSizes(children []flexChild, totalSize int) []int {
+	sizes := make([]int, len(children))
+	free := make([]bool, len(children))
+	available := totalSize
+	for i := range children {
+		child := &children[i]
+		if child.size >= 0 {
+			sizes[i] = child.clamp(child.size)
+			available -= sizes[i]
 		} else {
-			child.screen.Height = height
-			child.screen.Width = size
-			child.screen.OffsetY = 0
-			child.screen.OffsetX = offset
+			free[i] = true
+		}
+	}
+	for {
+		parts := 0
+		for i, child := range children {
+			if free[i] {
+				parts -= child.size
+			}
+		}
+		if parts == 0 {
+			break
+		}
+		space := max(available, 0)
+		assigned := 0
+		for i, child := range children {
+			if free[i] {
+				sizes[i] = space * -child.size / parts
+				assigned += sizes[i]
+			}
 		}
-		offset += size
-		if flex.focused == nil || child != *flex.focused {
-			child.target.Draw(child.screen)
+		// Hand out the remainder of the integer division one cell at a time so that no space is left empty.
+		for i := 0; i < len(sizes) && assigned < space; i++ {
+			if free[i] {
+				sizes[i]++
+				assigned++
+			}
+		}
+		// Freeze the children that violate their constraints, then distribute the rest among the others again.
+		// If both kinds of violations exist, the larger one is resolved first, like in CSS flexbox.
+		growth, shrinkage := 0, 0
+		for i := range children {
+			if free[i] {
+				if clamped := children[i].clamp(sizes[i]); clamped > sizes[i] {
+					growth += clamped - sizes[i]
+				} else {
+					shrinkage += sizes[i] - clamped
+				}
+			}
+		}
+		if growth == 0 && shrinkage == 0 {
+			break
+		}
+		for i := range children {
+			if !free[i] {
+				continue
+			}
+			clamped := children[i].clamp(sizes[i])
+			if (growth >= shrinkage && clamped > sizes[i]) || (growth < shrinkage && clamped < sizes[i]) {
+				sizes[i] = clamped
+				free[i] = false
+				available -= clamped
+			}
 		}
 	}
-	if flex.focused != nil {
-		flex.focused.target.Draw(flex.focused.screen)
+
+	overflow := -totalSize
+	for _, size := range sizes {
+		overflow += size
+	}
+	// If there isn't enough space, shrink children down to their minimum size first, and then below it until
+	// everything fits, starting from the last child in both cases.
+	for pass := 0; pass < 2 && overflow > 0; pass++ {
+		for i := len(sizes) - 1; i >= 0 && overflow > 0; i-- {
+			floor := 0
+			if pass == 0 {
+				floor = min(children[i].minSize, sizes[i])
+			}
+			shrink := min(overflow, sizes[i]-floor)
+			sizes[i] -= shrink
+			overflow -= shrink
+		}
 	}
+	return sizes
 }
 
 func (flex *Flex) OnKeyEvent(event KeyEvent) bool {
 	if flex.focused != nil {
-		return flex.focused.target.OnKeyEvent(event)
+		return flex.focused.OnKeyEvent(event)
 	}
 	return false
 }
 
 func (flex *Flex) OnPasteEvent(event PasteEvent) bool {
 	if flex.focused != nil {
-		return flex.focused.target.OnPasteEvent(event)
+		return flex.focused.OnPasteEvent(event)
 	}
 	return false
 }
 
+// SetFocused moves the focus to the given child component, blurring the previously focused child first.
+// If the component is nil, the focus is cleared. Components that aren't children of the flex are ignored.
 func (flex *Flex) SetFocused(comp Component) {
+	if comp == nil {
+		flex.Blur()
+		return
+	}
+	if comp == flex.focused || flex.findChild(comp) == nil {
+		return
+	}
+	flex.setFocused(comp)
+}
+
+// setFocused blurs the previously focused child, if any, and focuses the given child, or clears the focus if it's nil.
+func (flex *Flex) setFocused(comp Component) {
+	if focusable, ok := flex.focused.(Focusable); ok {
+		focusable.Blur()
+	}
+	flex.focused = comp
+	if focusable, ok := comp.(Focusable); ok {
+		focusable.Focus()
+	}
+}
+
+// GetFocused returns the child component that currently has focus, or nil if no child is focused.
+func (flex *Flex) GetFocused() Component {
+	return flex.focused
+}
+
+func (flex *Flex) findChild(comp Component) *flexChild {
+	if comp == nil {
+		return nil
+	}
 	for i := range flex.children {
-		childp := &flex.children[i]
-		if childp.target == comp {
-			flex.focused = childp
-			flex.focused.Focus()
-			break
+		if flex.children[i].target == comp {
+			return &flex.children[i]
 		}
 	}
+	return nil
+}
+
+// Children returns the child components of the flex in order.
+func (flex *Flex) Children() []Component {
+	comps := make([]Component, len(flex.children))
+	for i, child := range flex.children {
+		comps[i] = child.target
+	}
+	return comps
+}
+
+func (flex *Flex) focusedChild() Component {
+	return flex.GetFocused()
+}
+
+func (flex *Flex) focusChild(comp Component) bool {
+	if flex.findChild(comp) == nil {
+		return false
+	}
+	flex.SetFocused(comp)
+	return true
+}
+
+// ChildArea returns the area of the given child relative to the flex, as of the last draw.
+func (flex *Flex) ChildArea(comp Component) (Rect, bool) {
+	child := flex.findChild(comp)
+	if child == nil {
+		return Rect{}, false
+	}
+	return child.screen.area(), true
 }
 
 func (flex *Flex) OnMouseEvent(event MouseEvent) bool {
-	if flex.focused != nil && flex.focused.screen.IsInArea(event.Position()) {
-		screen := flex.focused.screen
-		return flex.focused.target.OnMouseEvent(OffsetMouseEvent(event, -screen.OffsetX, -screen.OffsetY))
+	if focused := flex.findChild(flex.focused); focused != nil && focused.screen.IsInArea(event.Position()) {
+		screen := focused.screen
+		return focused.target.OnMouseEvent(OffsetMouseEvent(event, -screen.OffsetX, -screen.OffsetY))
 	}
 	for _, child := range flex.children {
 		if child.screen.IsInArea(event.Position()) {
 			focusChanged := false
 			if event.Buttons() == tcell.Button1 && !event.HasMotion() {
-				if flex.focused != nil {
-					flex.focused.Blur()
-				}
-				flex.focused = &child
-				flex.focused.Focus()
+				flex.setFocused(child.target)
 				focusChanged = true
 			}
 			return child.target.OnMouseEvent(OffsetMouseEvent(event, -child.screen.OffsetX, -child.screen.OffsetY)) ||
@@ -158,8 +533,7 @@ func (flex *Flex) OnMouseEvent(event MouseEvent) bool {
 		}
 	}
 	if event.Buttons() == tcell.Button1 && flex.focused != nil && !event.HasMotion() {
-		flex.focused.Blur()
-		flex.focused = nil
+		flex.setFocused(nil)
 		return true
 	}
 	return false
@@ -169,7 +543,6 @@ func (flex *Flex) Focus() {}
 
 func (flex *Flex) Blur() {
 	if flex.focused != nil {
-		flex.focused.Blur()
-		flex.focused = nil
+		flex.setFocused(nil)
 	}
 }
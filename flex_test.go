@@ -0,0 +1,197 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// focusCounter is a focusable component that counts how many times it's drawn, focused and blurred.
+type focusCounter struct {
+	SimpleEventHandler
+	draws, focuses, blurs int
+}
+
+func (fc *focusCounter) Draw(screen Screen) {
+	fc.draws++
+}
+
+func (fc *focusCounter) Focus() {
+	fc.focuses++
+}
+
+func (fc *focusCounter) Blur() {
+	fc.blurs++
+}
+
+func TestFlex_FocusSurvivesChildChanges(t *testing.T) {
+	first, second := &focusCounter{}, &focusCounter{}
+	flex := NewFlex().AddProportionalComponent(first, 1).AddProportionalComponent(second, 1)
+	RenderSnapshot(flex, 10, 1)
+	flex.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(7, 0, tcell.Button1, tcell.ModNone), false})
+	flex.SetCrossSize(second, 1).SetSizeConstraints(second, 2, 10)
+	flex.SetFocused(second)
+	if flex.GetFocused() != second || second.focuses != 1 || second.blurs != 0 {
+		t.Errorf("expected refocusing a modified child to do nothing, got %d focuses and %d blurs",
+			second.focuses, second.blurs)
+	}
+
+	RenderSnapshot(flex, 10, 1)
+	if first.draws != 2 || second.draws != 2 {
+		t.Errorf("expected each child to be drawn once per frame, got %d and %d draws", first.draws, second.draws)
+	}
+
+	flex.SetFocused(first)
+	if flex.GetFocused() != first || first.focuses != 1 || second.blurs != 1 {
+		t.Errorf("expected focus to move to the first child")
+	}
+	flex.RemoveComponent(first)
+	if flex.GetFocused() != nil {
+		t.Errorf("expected removing the focused child to clear the focus")
+	}
+}
+
+func TestFlex_MouseFocus(t *testing.T) {
+	left, right := NewInputField(), NewInputField()
+	flex := NewFlex().AddProportionalComponent(left, 1).AddProportionalComponent(right, 1)
+	sim := startSimulation(t, flex, 10, 1)
+
+	sim.InjectClick(7, 0).InjectString("b")
+	waitForDraw(t, sim)
+	flex.SetSizeConstraints(right, 4, 0)
+	sim.InjectClick(8, 0).InjectString("c")
+	waitForDraw(t, sim)
+	if flex.GetFocused() != right || right.GetText() != "bc" {
+		t.Fatalf("expected clicks to focus the right field, got %q", right.GetText())
+	}
+	sim.InjectClick(0, 0).InjectKey(tcell.KeyRune, 'a', tcell.ModNone)
+	waitForDraw(t, sim)
+	if flex.GetFocused() != left || left.GetText() != "a" || right.focused {
+		t.Errorf("expected click to move focus to the left field")
+	}
+}
+
+func TestFlex_AutoSizedTextView(t *testing.T) {
+	textView := NewTextView().SetText("hello world this is wrapped")
+	textView.SetWrap(true).SetWordWrap(true)
+	flex := NewFlex().SetDirection(FlexRow).
+		AddProportionalComponent(NewTextView(), 1).
+		AddAutoComponent(textView)
+	areas := flex.layout(12, 10)
+	if areas[1] != (Rect{X: 0, Y: 7, Width: 12, Height: 3}) {
+		t.Errorf("expected the text view to get the three lines it needs at the bottom, got %v", areas[1])
+	}
+}
+
+func TestLayoutSizes(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		children []flexChild
+		total    int
+		expected []int
+	}{
+		{"remainder goes to first children", []flexChild{{size: -1}, {size: -1}, {size: -1}}, 10, []int{4, 3, 3}},
+		{"fixed and proportional", []flexChild{{size: 4}, {size: -1}, {size: -2}}, 13, []int{4, 3, 6}},
+		{"no space", []flexChild{{size: -1}, {size: -1}}, 0, []int{0, 0}},
+		{"maximum gives space to others", []flexChild{{size: -1, maxSize: 2}, {size: -1}}, 10, []int{2, 8}},
+		{"minimum takes space from others", []flexChild{{size: -1, minSize: 7}, {size: -1}, {size: -1}}, 12, []int{7, 3, 2}},
+		{"all proportional at maximum", []flexChild{{size: -1, maxSize: 2}, {size: -1, maxSize: 3}}, 10, []int{2, 3}},
+		{"fixed sizes are clamped", []flexChild{{size: 10, maxSize: 6}, {size: 1, minSize: 3}, {size: -1}}, 12, []int{6, 3, 3}},
+		{"overflow shrinks to minimum from the end", []flexChild{{size: 5, minSize: 3}, {size: 5, minSize: 3}}, 7, []int{4, 3}},
+		{"overflow cuts off the last child", []flexChild{{size: -1, minSize: 3}, {size: -1, minSize: 3}}, 4, []int{3, 1}},
+		{"overflow collapses the last child", []flexChild{{size: -1, minSize: 3}, {size: -1, minSize: 3}}, 3, []int{3, 0}},
+		{"overflow without minimums", []flexChild{{size: 4}, {size: 4}, {size: 4}}, 6, []int{4, 2, 0}},
+	} {
+		if sizes := layoutSizes(test.children, test.total); !slices.Equal(sizes, test.expected) {
+			t.Errorf("%s: expected sizes %v, got %v", test.name, test.expected, sizes)
+		}
+	}
+}
+
+func TestFlex_SizeConstraintsOverflow(t *testing.T) {
+	a, b := NewTextView(), NewTextView()
+	flex := NewFlex().SetGap(1).
+		AddProportionalComponent(a, 1).
+		AddProportionalComponent(b, 1).
+		SetSizeConstraints(a, 3, 0).
+		SetSizeConstraints(b, 3, 0)
+	// The second child is cut off below its minimum size when there isn't enough space.
+	if areas := flex.layout(5, 1); !slices.Equal(areas, []Rect{{0, 0, 3, 1}, {4, 0, 1, 1}}) {
+		t.Errorf("expected the second child to be cut off, got %v", areas)
+	}
+	// If there's no space left for it at all, it's collapsed completely.
+	if areas := flex.layout(4, 1); !slices.Equal(areas, []Rect{{0, 0, 3, 1}, {4, 0, 0, 1}}) {
+		t.Errorf("expected the second child to be collapsed, got %v", areas)
+	}
+}
+
+func TestFlex_Wrap(t *testing.T) {
+	a, b, c, d, e := NewTextView(), NewTextView(), NewTextView(), NewTextView(), NewTextView()
+	flex := NewFlex().SetWrap(true).SetGap(1).
+		AddFixedComponent(a, 4).
+		AddFixedComponent(b, 4).
+		AddProportionalComponent(c, 1).
+		AddFixedComponent(d, 3).
+		AddFixedComponent(e, 10).
+		SetCrossSize(a, 1).
+		SetCrossSize(b, 2).
+		SetCrossSize(e, 5)
+	expected := []Rect{
+		// The first line is as thick as its thickest child, and the children are stretched to fill it.
+		{0, 0, 4, 2}, {5, 0, 4, 2},
+		// The proportional child on the second line gets the space that's left after the fixed child.
+		{0, 3, 6, 1}, {7, 3, 3, 1},
+		// The last line is cut off at the bottom of the flex.
+		{0, 5, 10, 1},
+	}
+	if areas := flex.layout(10, 6); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
+
+func TestFlex_Alignment(t *testing.T) {
+	a, b, c := NewTextView(), NewTextView(), NewTextView()
+	flex := NewFlex().
+		AddFixedComponent(a, 2).
+		AddFixedComponent(b, 2).
+		AddFixedComponent(c, 2).
+		SetCrossSize(a, 2).
+		SetCrossSize(c, 9)
+	for _, test := range []struct {
+		alignment FlexAlignment
+		expected  []Rect
+	}{
+		{FlexStretch, []Rect{{0, 0, 2, 5}, {2, 0, 2, 5}, {4, 0, 2, 5}}},
+		{FlexStart, []Rect{{0, 0, 2, 2}, {2, 0, 2, 5}, {4, 0, 2, 5}}},
+		{FlexCenter, []Rect{{0, 1, 2, 2}, {2, 0, 2, 5}, {4, 0, 2, 5}}},
+		{FlexEnd, []Rect{{0, 3, 2, 2}, {2, 0, 2, 5}, {4, 0, 2, 5}}},
+	} {
+		flex.SetAlignment(test.alignment)
+		if areas := flex.layout(6, 5); !slices.Equal(areas, test.expected) {
+			t.Errorf("expected areas %v with alignment %d, got %v", test.expected, test.alignment, areas)
+		}
+	}
+}
+
+func TestFlex_GapAndPaddingWithConstraints(t *testing.T) {
+	a, b, c := NewTextView(), NewTextView(), NewTextView()
+	flex := NewFlex().SetDirection(FlexRow).SetGap(1).SetPadding(1, 1, 2, 0).SetAlignment(FlexEnd).
+		AddProportionalComponent(a, 1).
+		AddProportionalComponent(b, 1).
+		AddFixedComponent(c, 3).
+		SetSizeConstraints(a, 0, 1).
+		SetSizeConstraints(c, 4, 0).
+		SetCrossSize(c, 3)
+	expected := []Rect{{2, 1, 6, 1}, {2, 3, 6, 3}, {5, 7, 3, 4}}
+	if areas := flex.layout(8, 12); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
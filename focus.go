@@ -0,0 +1,350 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+)
+
+// focusContainer is implemented by the built-in containers to let the focus manager move focus in the tree.
+type focusContainer interface {
+	Container
+	// focusedChild returns the child that currently has focus within the container, or nil.
+	focusedChild() Component
+	// focusChild moves the focus within the container to the given child. Returns false if it's not a child.
+	focusChild(child Component) bool
+}
+
+// focusLimiter is implemented by containers where only some of the children can have focus, e.g. Pages.
+type focusLimiter interface {
+	// focusableChildren returns the children that can currently have focus.
+	focusableChildren() []Component
+}
+
+// focusableChildren returns the children of the container that can currently have focus.
+func focusableChildren(container Container) []Component {
+	if limiter, ok := container.(focusLimiter); ok {
+		return limiter.focusableChildren()
+	}
+	return container.Children()
+}
+
+type FocusDirection int
+
+const (
+	FocusLeft FocusDirection = iota
+	FocusRight
+	FocusUp
+	FocusDown
+)
+
+// FocusKeymap is the keymap used by the focus manager. It only receives key events that weren't handled by
+// any component, so e.g. Tab only moves focus if the focused component doesn't use Tab itself.
+// Directional moves are bound to Alt and the arrow keys, so that plain arrow keys still reach the components.
+var FocusKeymap = newDefaultKeymap("focus", []string{
+	"focus-next", "focus-previous", "focus-left", "focus-right", "focus-up", "focus-down",
+}, map[string]string{
+	"Tab":       "focus-next",
+	"Backtab":   "focus-previous",
+	"Alt+Left":  "focus-left",
+	"Alt+Right": "focus-right",
+	"Alt+Up":    "focus-up",
+	"Alt+Down":  "focus-down",
+})
+
+// FocusManager moves focus around the whole component tree of an application.
+//
+// Focus stops are components that implement Focusable and don't contain any focusable components themselves,
+// e.g. input fields, buttons and boxes around text views. Moving focus to a component focuses every container
+// on the path to it, and blurs whatever was previously focused in those containers.
+//
+// The methods of the focus manager must only be called from the main loop, e.g. in event handlers.
+type FocusManager struct {
+	app       *Application
+	current   Component
+	onChanged func(from, to Component)
+	keyChord  ChordState
+}
+
+type focusStop struct {
+	path []Component
+	area Rect
+}
+
+func (fs *focusStop) target() Component {
+	return fs.path[len(fs.path)-1]
+}
+
+// FocusManager returns the focus manager of the application.
+func (app *Application) FocusManager() *FocusManager {
+	return app.focusManager
+}
+
+// SetOnFocusChanged sets a function that is called whenever the focused component changes anywhere in the tree,
+// regardless of whether the change was caused by the focus manager, a mouse click or a container method.
+func (fm *FocusManager) SetOnFocusChanged(fn func(from, to Component)) *FocusManager {
+	fm.onChanged = fn
+	return fm
+}
+
+// scope returns the root of the tree that can currently receive focus: the topmost modal layer if there is one,
+// and the root component otherwise.
+func (fm *FocusManager) scope() Component {
+	layers := fm.app.sortLayers()
+	for i := len(layers) - 1; i >= 0; i-- {
+		if layers[i].modal {
+			return layers[i].component
+		}
+	}
+	return fm.app.root
+}
+
+// Focused returns the innermost component that currently has focus, or nil if nothing is focused.
+func (fm *FocusManager) Focused() Component {
+	comp := fm.scope()
+	for comp != nil {
+		container, ok := comp.(focusContainer)
+		if !ok {
+			break
+		}
+		child := container.focusedChild()
+		if child == nil {
+			break
+		}
+		comp = child
+	}
+	return comp
+}
+
+// checkChanged calls the focus changed callback if the focused component has changed since the last call.
+func (fm *FocusManager) checkChanged() {
+	focused := fm.Focused()
+	if focused == fm.current {
+		return
+	}
+	prev := fm.current
+	fm.current = focused
+	if fm.onChanged != nil {
+		fm.onChanged(prev, focused)
+	}
+}
+
+// focusOrder returns the children of the container sorted by their position on the screen,
+// so that focus moves from top to bottom and left to right.
+func focusOrder(container Container) []Component {
+	children := focusableChildren(container)
+	slices.SortStableFunc(children, func(a, b Component) int {
+		areaA, _ := container.ChildArea(a)
+		areaB, _ := container.ChildArea(b)
+		if areaA.Y != areaB.Y {
+			return areaA.Y - areaB.Y
+		}
+		return areaA.X - areaB.X
+	})
+	return children
+}
+
+func hasFocusableDescendants(container focusContainer) bool {
+	for _, child := range focusableChildren(container) {
+		if _, ok := child.(Focusable); ok {
+			return true
+		} else if childContainer, ok := child.(focusContainer); ok && hasFocusableDescendants(childContainer) {
+			return true
+		}
+	}
+	return false
+}
+
+func collectFocusStops(comp Component, path []Component, area Rect, into []focusStop) []focusStop {
+	path = append(path, comp)
+	container, isContainer := comp.(focusContainer)
+	if isContainer && hasFocusableDescendants(container) {
+		for _, child := range focusOrder(container) {
+			childArea, _ := container.ChildArea(child)
+			childArea.X += area.X
+			childArea.Y += area.Y
+			into = collectFocusStops(child, path[:len(path):len(path)], childArea, into)
+		}
+	} else if _, ok := comp.(Focusable); ok {
+		into = append(into, focusStop{path: path, area: area})
+	}
+	return into
+}
+
+func (fm *FocusManager) focusStops() []focusStop {
+	scope := fm.scope()
+	if scope == nil {
+		return nil
+	}
+	width, height := 0, 0
+	if fm.app.screen != nil {
+		width, height = fm.app.screen.Size()
+	}
+	return collectFocusStops(scope, nil, Rect{Width: width, Height: height}, nil)
+}
+
+func (fm *FocusManager) currentStop(stops []focusStop) int {
+	focused := fm.Focused()
+	for i, stop := range stops {
+		if stop.target() == focused {
+			return i
+		}
+	}
+	return -1
+}
+
+func (fm *FocusManager) focusPath(path []Component) {
+	for i := 0; i < len(path)-1; i++ {
+		if container, ok := path[i].(focusContainer); ok {
+			container.focusChild(path[i+1])
+		}
+	}
+	fm.checkChanged()
+}
+
+// SetFocus moves the focus to the given component, which can be anywhere in the component tree.
+// Returns false if the component wasn't found.
+func (fm *FocusManager) SetFocus(comp Component) bool {
+	path := findFocusPath(fm.scope(), comp, nil)
+	if path == nil {
+		return false
+	}
+	fm.focusPath(path)
+	return true
+}
+
+func findFocusPath(from, target Component, path []Component) []Component {
+	if from == nil {
+		return nil
+	}
+	path = append(path, from)
+	if from == target {
+		return path
+	}
+	if container, ok := from.(focusContainer); ok {
+		for _, child := range focusableChildren(container) {
+			if found := findFocusPath(child, target, path[:len(path):len(path)]); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// FocusNext moves the focus to the next focus stop in the tree, wrapping around at the end.
+// Returns false if there are no focus stops.
+func (fm *FocusManager) FocusNext() bool {
+	return fm.focusRelative(1)
+}
+
+// FocusPrevious moves the focus to the previous focus stop in the tree, wrapping around at the start.
+// Returns false if there are no focus stops.
+func (fm *FocusManager) FocusPrevious() bool {
+	return fm.focusRelative(-1)
+}
+
+func (fm *FocusManager) focusRelative(delta int) bool {
+	stops := fm.focusStops()
+	if len(stops) == 0 {
+		return false
+	}
+	current := fm.currentStop(stops)
+	var next int
+	if current < 0 {
+		if delta < 0 {
+			next = len(stops) - 1
+		}
+	} else {
+		next = (current + delta + len(stops)) % len(stops)
+	}
+	fm.focusPath(stops[next].path)
+	return true
+}
+
+// FocusDirection moves the focus to the nearest focus stop in the given direction, based on where the components
+// were drawn on the screen. Returns false if there's nothing in that direction.
+func (fm *FocusManager) FocusDirection(direction FocusDirection) bool {
+	stops := fm.focusStops()
+	current := fm.currentStop(stops)
+	if current < 0 {
+		if len(stops) == 0 {
+			return false
+		}
+		fm.focusPath(stops[0].path)
+		return true
+	}
+	from := stops[current].area
+	best, bestScore := -1, 0
+	for i, stop := range stops {
+		if i == current {
+			continue
+		}
+		score, ok := directionalScore(from, stop.area, direction)
+		if ok && (best < 0 || score < bestScore) {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return false
+	}
+	fm.focusPath(stops[best].path)
+	return true
+}
+
+// directionalScore returns how good of a candidate the target area is when moving focus in the given direction.
+// Lower scores are better. Areas that overlap on the perpendicular axis are strongly preferred.
+func directionalScore(from, to Rect, direction FocusDirection) (int, bool) {
+	var distance, gap int
+	switch direction {
+	case FocusLeft:
+		distance = from.X - (to.X + to.Width)
+		gap = rangeGap(from.Y, from.Height, to.Y, to.Height)
+	case FocusRight:
+		distance = to.X - (from.X + from.Width)
+		gap = rangeGap(from.Y, from.Height, to.Y, to.Height)
+	case FocusUp:
+		distance = from.Y - (to.Y + to.Height)
+		gap = rangeGap(from.X, from.Width, to.X, to.Width)
+	case FocusDown:
+		distance = to.Y - (from.Y + from.Height)
+		gap = rangeGap(from.X, from.Width, to.X, to.Width)
+	}
+	if distance < 0 {
+		return 0, false
+	}
+	return distance + gap*4, true
+}
+
+func rangeGap(start1, length1, start2, length2 int) int {
+	if start2 >= start1+length1 {
+		return start2 - (start1 + length1) + 1
+	} else if start1 >= start2+length2 {
+		return start1 - (start2 + length2) + 1
+	}
+	return 0
+}
+
+// onKeyEvent handles key events that weren't handled by any component.
+func (fm *FocusManager) onKeyEvent(event KeyEvent) bool {
+	action, consumed := FocusKeymap.Process(&fm.keyChord, event)
+	switch action {
+	case "focus-next":
+		return fm.FocusNext()
+	case "focus-previous":
+		return fm.FocusPrevious()
+	case "focus-left":
+		return fm.FocusDirection(FocusLeft)
+	case "focus-right":
+		return fm.FocusDirection(FocusRight)
+	case "focus-up":
+		return fm.FocusDirection(FocusUp)
+	case "focus-down":
+		return fm.FocusDirection(FocusDown)
+	}
+	return consumed
+}
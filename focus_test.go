@@ -0,0 +1,79 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestFocusManager_Keys(t *testing.T) {
+	// +-----------+-----------+
+	// | topLeft   | topRight  |
+	// |           +-----------+
+	// |           | midRight  |
+	// +-----------+-----------+
+	// | bottom                |
+	// +-----------------------+
+	topLeft, topRight, midRight, bottom := NewButton("a"), NewButton("b"), NewButton("c"), NewButton("d")
+	right := NewFlex().SetDirection(FlexRow).
+		AddProportionalComponent(topRight, 1).
+		AddProportionalComponent(midRight, 1)
+	grid := NewGrid().SetColumns([]int{-1, -1}).SetRows([]int{-1, 1}).
+		AddComponent(topLeft, 0, 0, 1, 1).
+		AddComponent(right, 1, 0, 1, 1).
+		AddComponent(bottom, 0, 1, 2, 1)
+	app := NewApplication()
+	app.SetRoot(grid)
+	var changes []Component
+	app.FocusManager().SetOnFocusChanged(func(from, to Component) {
+		changes = append(changes, to)
+	})
+	sim, err := StartSimulation(app, 20, 5)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	defer sim.Stop()
+	fm := app.FocusManager()
+	// Ignore the change from nothing to the root component on startup.
+	changes = nil
+
+	steps := []struct {
+		key      tcell.Key
+		mod      tcell.ModMask
+		expected *Button
+	}{
+		{tcell.KeyTab, tcell.ModNone, topLeft},
+		{tcell.KeyTab, tcell.ModNone, topRight},
+		{tcell.KeyDown, tcell.ModAlt, midRight},
+		{tcell.KeyLeft, tcell.ModAlt, topLeft},
+		{tcell.KeyDown, tcell.ModAlt, bottom},
+		{tcell.KeyUp, tcell.ModAlt, topLeft},
+		{tcell.KeyRight, tcell.ModAlt, topRight},
+		{tcell.KeyBacktab, tcell.ModShift, topLeft},
+		{tcell.KeyBacktab, tcell.ModShift, bottom},
+		// Plain arrow keys aren't bound to focus movement.
+		{tcell.KeyUp, tcell.ModNone, bottom},
+	}
+	for i, step := range steps {
+		sim.InjectKey(step.key, 0, step.mod)
+		waitForDraw(t, sim)
+		if focused := fm.Focused(); focused != step.expected {
+			t.Fatalf("step %d: expected button %q to be focused, got %v", i, step.expected.text, focused)
+		}
+		for _, button := range []*Button{topLeft, topRight, midRight, bottom} {
+			if button.focused != (button == step.expected) {
+				t.Fatalf("step %d: expected only button %q to be focused", i, step.expected.text)
+			}
+		}
+	}
+	if len(changes) != len(steps)-1 {
+		t.Errorf("expected %d focus change callbacks, got %d", len(steps)-1, len(changes))
+	}
+}
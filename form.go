@@ -7,13 +7,11 @@
 
 package mauview
 
-import (
-	"github.com/gdamore/tcell/v2"
-)
-
 type Form struct {
 	*Grid
-	items []*gridChild
+	items    []*gridChild
+	keymap   *Keymap
+	keyChord ChordState
 }
 
 type FormItem interface {
@@ -51,6 +49,19 @@ func (form *Form) FocusPreviousItem() {
 	form.setFocused(form.items[len(form.items)-1])
 }
 
+// FocusItem moves the focus to the given form item. Components that aren't items of the form are ignored.
+func (form *Form) FocusItem(comp Component) *Form {
+	for _, item := range form.items {
+		if item.target == comp {
+			if form.focused != item {
+				form.setFocused(item)
+			}
+			break
+		}
+	}
+	return form
+}
+
 func (form *Form) AddFormItem(comp Component, x, y, width, height int) *Form {
 	child := form.Grid.createChild(comp, x, y, width, height)
 	form.items = append(form.items, child)
@@ -68,15 +79,39 @@ func (form *Form) RemoveFormItem(comp Component) *Form {
 	return form
 }
 
+// FormKeymap is the default keymap of forms. Keys that aren't bound are passed to the focused item,
+// unless the same key without modifiers is bound (e.g. Alt+Enter is handled like Enter).
+var FormKeymap = newDefaultKeymap("form", []string{"next-item", "previous-item", "submit"}, map[string]string{
+	"Tab":     "next-item",
+	"Backtab": "previous-item",
+	"Enter":   "submit",
+})
+
+// SetKeymap sets the keymap used by this form. If nil, FormKeymap is used.
+func (form *Form) SetKeymap(keymap *Keymap) *Form {
+	form.keymap = keymap
+	form.keyChord.Reset()
+	return form
+}
+
 func (form *Form) OnKeyEvent(event KeyEvent) bool {
-	switch event.Key() {
-	case tcell.KeyTab:
+	keymap := form.keymap
+	if keymap == nil {
+		keymap = FormKeymap
+	}
+	action, consumed := keymap.Process(&form.keyChord, event)
+	if !consumed {
+		// Modifier combinations that aren't bound are handled like the same key without modifiers.
+		action = keymap.lookupWithoutModifiers(event)
+	}
+	switch action {
+	case "next-item":
 		form.FocusNextItem()
 		return true
-	case tcell.KeyBacktab:
+	case "previous-item":
 		form.FocusPreviousItem()
 		return true
-	case tcell.KeyEnter:
+	case "submit":
 		if form.focused != nil {
 			if fi, ok := form.focused.target.(FormItem); ok {
 				if fi.Submit(event) {
@@ -87,6 +122,10 @@ func (form *Form) OnKeyEvent(event KeyEvent) bool {
 				}
 			}
 		}
+	case "":
+		if consumed {
+			return true
+		}
 	}
 	return form.Grid.OnKeyEvent(event)
 }
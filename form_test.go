@@ -0,0 +1,36 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestForm_ModifiedKeys(t *testing.T) {
+	checkbox := NewCheckbox("Enable")
+	first, second := NewInputField(), NewInputField()
+	form := NewForm().
+		AddFormItem(checkbox, 0, 0, 1, 1).
+		AddFormItem(first, 0, 1, 1, 1).
+		AddFormItem(second, 0, 2, 1, 1)
+	form.FocusItem(checkbox)
+	if !form.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModAlt)) {
+		t.Errorf("expected Alt+Enter to be handled")
+	}
+	if !checkbox.IsChecked() || form.GetFocused() != first {
+		t.Errorf("expected Alt+Enter to submit the checkbox and focus the next form item")
+	}
+	if !form.OnKeyEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModAlt)) || form.GetFocused() != second {
+		t.Errorf("expected Alt+Tab to focus the next form item")
+	}
+	if !form.OnKeyEvent(tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModAlt|tcell.ModShift)) || form.GetFocused() != first {
+		t.Errorf("expected Alt+Shift+Tab to focus the previous form item")
+	}
+}
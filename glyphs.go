@@ -0,0 +1,38 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+// Glyphs defines the default glyphs used when checkboxes, radio groups, drop-downs, tabs and menus are drawn.
+// These may be changed to accommodate a different look and feel.
+// The glyphs are drawn as-is, color tags are not supported in them.
+// Individual components can override them with their own setters.
+var Glyphs = struct {
+	CheckboxChecked   string
+	CheckboxUnchecked string
+	RadioSelected     string
+	RadioUnselected   string
+	DropDownArrow     string
+	TabClose          string
+	TabScrollLeft     string
+	TabScrollRight    string
+	TabScrollUp       string
+	TabScrollDown     string
+	MenuSubmenu       string
+}{
+	CheckboxChecked:   "[x]",
+	CheckboxUnchecked: "[ ]",
+	RadioSelected:     "(•)",
+	RadioUnselected:   "( )",
+	DropDownArrow:     "▼",
+	TabClose:          "×",
+	TabScrollLeft:     "◀",
+	TabScrollRight:    "▶",
+	TabScrollUp:       "▲",
+	TabScrollDown:     "▼",
+	MenuSubmenu:       "▶",
+}
@@ -0,0 +1,163 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// RenderSnapshot draws the given component onto an in-memory screen of the given size
+// and returns a snapshot of the result in the format described in FormatSnapshot.
+func RenderSnapshot(comp Component, width, height int) string {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		// The simulation screen only fails to initialize with unknown charsets.
+		panic(err)
+	}
+	defer screen.Fini()
+	screen.SetSize(width, height)
+	screen.Clear()
+	comp.Draw(screen)
+	screen.Show()
+	return FormatSnapshot(screen.GetContents())
+}
+
+// Snapshot returns a snapshot of the current content of the simulated screen
+// in the format described in FormatSnapshot.
+func (sim *Simulation) Snapshot() string {
+	return FormatSnapshot(sim.Screen.GetContents())
+}
+
+const snapshotStyleKeys = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// The Latin Extended-A, Latin Extended-B and IPA Extensions blocks, which only contain single-width letters.
+const (
+	firstExtraSnapshotStyleKey = '\u0100'
+	lastExtraSnapshotStyleKey  = '\u02AF'
+)
+
+// MaxSnapshotStyles is the maximum number of distinct styles that FormatSnapshot can format.
+const MaxSnapshotStyles = len(snapshotStyleKeys) + int(lastExtraSnapshotStyleKey-firstExtraSnapshotStyleKey) + 1
+
+func snapshotStyleKey(index int) rune {
+	if index < len(snapshotStyleKeys) {
+		return rune(snapshotStyleKeys[index])
+	} else if index >= MaxSnapshotStyles {
+		panic(fmt.Errorf("snapshot has more than %d distinct styles", MaxSnapshotStyles))
+	}
+	return firstExtraSnapshotStyleKey + rune(index-len(snapshotStyleKeys))
+}
+
+// FormatSnapshot formats the given cells into a human-readable snapshot.
+//
+// The snapshot has three sections: the characters on the screen, a style layer where each cell is replaced with
+// a key identifying its style, and a legend that describes the colors and attributes of each style key.
+// Style keys are assigned in order of first appearance: letters and digits are used first, followed by accented
+// letters. The function panics if there are more than MaxSnapshotStyles distinct styles.
+// Each row is wrapped in pipes to preserve trailing spaces.
+func FormatSnapshot(cells []tcell.SimCell, width, height int) string {
+	var text, styles, legend strings.Builder
+	keys := make(map[tcell.Style]rune)
+	text.WriteString("-- text --\n")
+	styles.WriteString("-- style --\n")
+	legend.WriteString("-- legend --\n")
+	for y := 0; y < height; y++ {
+		text.WriteByte('|')
+		styles.WriteByte('|')
+		for x := 0; x < width; x++ {
+			cell := cells[y*width+x]
+			key, ok := keys[cell.Style]
+			if !ok {
+				key = snapshotStyleKey(len(keys))
+				keys[cell.Style] = key
+				_, _ = fmt.Fprintf(&legend, "%c: %s\n", key, describeStyle(cell.Style))
+			}
+			styles.WriteRune(key)
+			if len(cell.Runes) == 0 || cell.Runes[0] == 0 {
+				text.WriteByte(' ')
+				continue
+			}
+			for _, r := range cell.Runes {
+				text.WriteRune(r)
+			}
+			if runewidth.RuneWidth(cell.Runes[0]) == 2 && x+1 < width {
+				// The wide character covers the next cell, so repeat the key to keep the layers aligned.
+				styles.WriteRune(key)
+				x++
+			}
+		}
+		text.WriteString("|\n")
+		styles.WriteString("|\n")
+	}
+	return text.String() + styles.String() + legend.String()
+}
+
+var styleAttrNames = []struct {
+	attr tcell.AttrMask
+	name string
+}{
+	{tcell.AttrBold, "bold"},
+	{tcell.AttrDim, "dim"},
+	{tcell.AttrItalic, "italic"},
+	{tcell.AttrUnderline, "underline"},
+	{tcell.AttrStrikeThrough, "strikethrough"},
+	{tcell.AttrBlink, "blink"},
+	{tcell.AttrReverse, "reverse"},
+}
+
+func describeStyle(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+	desc := fmt.Sprintf("fg=%s bg=%s", colorName(fg), colorName(bg))
+	var attrNames []string
+	for _, attr := range styleAttrNames {
+		if attrs&attr.attr != 0 {
+			attrNames = append(attrNames, attr.name)
+		}
+	}
+	if len(attrNames) > 0 {
+		desc += " " + strings.Join(attrNames, ",")
+	}
+	return desc
+}
+
+var (
+	colorNamesOnce sync.Once
+	colorNames     map[tcell.Color]string
+)
+
+// colorName returns a stable name for the given color.
+// tcell.Color.Name isn't used, because it's not deterministic for colors with several names (e.g. gray and grey).
+func colorName(color tcell.Color) string {
+	colorNamesOnce.Do(func() {
+		names := make([]string, 0, len(tcell.ColorNames))
+		for name := range tcell.ColorNames {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		colorNames = make(map[tcell.Color]string, len(names))
+		for _, name := range names {
+			if _, exists := colorNames[tcell.ColorNames[name]]; !exists {
+				colorNames[tcell.ColorNames[name]] = name
+			}
+		}
+	})
+	if color == tcell.ColorDefault {
+		return "default"
+	} else if name, ok := colorNames[color]; ok {
+		return name
+	} else if css := color.CSS(); css != "" {
+		return css
+	}
+	return fmt.Sprintf("%d", color)
+}
@@ -0,0 +1,90 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"flag"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"go.mau.fi/mauview/mauviewtest"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata instead of comparing against them")
+
+func goldenPath(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+func assertGolden(t *testing.T, path string, comp Component, width, height int) {
+	t.Helper()
+	mauviewtest.AssertGolden(t, path, RenderSnapshot(comp, width, height), *updateGolden)
+}
+
+func assertGoldenSnapshot(t *testing.T, path, snapshot string) {
+	t.Helper()
+	mauviewtest.AssertGolden(t, path, snapshot, *updateGolden)
+}
+
+func TestGolden_Box(t *testing.T) {
+	box := NewBox(NewTextView().SetText("Hello")).SetTitle("Title")
+	assertGolden(t, goldenPath("box"), box, 12, 4)
+}
+
+func TestGolden_BoxWithoutBorder(t *testing.T) {
+	box := NewBox(NewTextView().SetText("Hello")).SetBorder(false)
+	assertGolden(t, goldenPath("box_no_border"), box, 8, 2)
+}
+
+func TestGolden_TextView(t *testing.T) {
+	textView := NewTextView().SetText("Hello [red]world[-], 日本語 text that wraps")
+	textView.SetDynamicColors(true).SetWrap(true).SetWordWrap(true)
+	assertGolden(t, goldenPath("textview"), textView, 14, 4)
+}
+
+func TestGolden_ProgressBar(t *testing.T) {
+	progressBar := NewProgressBar().SetIndeterminate(false).SetMax(16).SetProgress(5)
+	assertGolden(t, goldenPath("progressbar"), progressBar, 10, 1)
+}
+
+func TestFormatSnapshot_ManyStyles(t *testing.T) {
+	const width = 100
+	cells := make([]tcell.SimCell, width)
+	for i := range cells {
+		cells[i] = tcell.SimCell{Runes: []rune{'x'}, Style: tcell.StyleDefault.Foreground(tcell.PaletteColor(i))}
+	}
+	snapshot := FormatSnapshot(cells, width, 1)
+	legend := snapshot[strings.Index(snapshot, "-- legend --\n"):]
+	keys := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(legend), "\n")[1:] {
+		key, _, _ := strings.Cut(line, ":")
+		if keys[key] {
+			t.Fatalf("style key %q is used for multiple styles", key)
+		}
+		keys[key] = true
+	}
+	if len(keys) != width {
+		t.Errorf("expected %d legend entries, got %d", width, len(keys))
+	}
+}
+
+func TestFormatSnapshot_TooManyStyles(t *testing.T) {
+	cells := make([]tcell.SimCell, MaxSnapshotStyles+1)
+	for i := range cells {
+		cells[i] = tcell.SimCell{Runes: []rune{'x'}, Style: tcell.StyleDefault.Foreground(tcell.NewHexColor(int32(i)))}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected FormatSnapshot to panic with too many styles")
+		}
+	}()
+	FormatSnapshot(cells, len(cells), 1)
+}
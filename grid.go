@@ -8,6 +8,9 @@
 package mauview
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/gdamore/tcell/v2"
 )
 
@@ -17,6 +20,99 @@ type gridChild struct {
 	relHeight int
 	relX      int
 	relY      int
+	// The name of the template area the child was placed in, if any (see Grid.SetTemplate).
+	area string
+
+	paddingTop      int
+	paddingBottom   int
+	paddingLeft     int
+	paddingRight    int
+	horizontalAlign FlexAlignment
+	verticalAlign   FlexAlignment
+}
+
+// gridPlacement contains the fields of a grid child that affect its position. Unlike gridChild, it doesn't contain
+// the component, so it can be compared safely even if the component's type isn't comparable.
+type gridPlacement struct {
+	relWidth, relHeight, relX, relY                      int
+	area                                                 string
+	paddingTop, paddingBottom, paddingLeft, paddingRight int
+	horizontalAlign, verticalAlign                       FlexAlignment
+}
+
+func (child *gridChild) placement() gridPlacement {
+	return gridPlacement{
+		relWidth:        child.relWidth,
+		relHeight:       child.relHeight,
+		relX:            child.relX,
+		relY:            child.relY,
+		area:            child.area,
+		paddingTop:      child.paddingTop,
+		paddingBottom:   child.paddingBottom,
+		paddingLeft:     child.paddingLeft,
+		paddingRight:    child.paddingRight,
+		horizontalAlign: child.horizontalAlign,
+		verticalAlign:   child.verticalAlign,
+	}
+}
+
+func (child *gridChild) setPlacement(placement gridPlacement) {
+	child.relWidth, child.relHeight, child.relX, child.relY =
+		placement.relWidth, placement.relHeight, placement.relX, placement.relY
+	child.area = placement.area
+	child.paddingTop, child.paddingBottom = placement.paddingTop, placement.paddingBottom
+	child.paddingLeft, child.paddingRight = placement.paddingLeft, placement.paddingRight
+	child.horizontalAlign, child.verticalAlign = placement.horizontalAlign, placement.verticalAlign
+}
+
+// place positions the child within the given cell area, taking its padding and alignment into account.
+func (child *gridChild) place(x, y, width, height int) {
+	x, y = x+child.paddingLeft, y+child.paddingTop
+	width = max(width-child.paddingLeft-child.paddingRight, 0)
+	height = max(height-child.paddingTop-child.paddingBottom, 0)
+	if child.horizontalAlign != FlexStretch || child.verticalAlign != FlexStretch {
+		if preferredWidth, preferredHeight, ok := preferredSize(child.target, width, height); ok {
+			x, width = alignSpan(x, width, preferredWidth, child.horizontalAlign)
+			y, height = alignSpan(y, height, preferredHeight, child.verticalAlign)
+		}
+	}
+	child.screen.OffsetX, child.screen.OffsetY = x, y
+	child.screen.Width, child.screen.Height = width, height
+}
+
+// alignSpan returns the offset and size of a component with the given preferred size inside the available space.
+func alignSpan(offset, available, preferred int, alignment FlexAlignment) (int, int) {
+	if alignment == FlexStretch || preferred >= available {
+		return offset, available
+	}
+	preferred = max(preferred, 0)
+	switch alignment {
+	case FlexCenter:
+		offset += (available - preferred) / 2
+	case FlexEnd:
+		offset += available - preferred
+	}
+	return offset, preferred
+}
+
+type gridBreakpoint struct {
+	width  int
+	height int
+	apply  func(grid *Grid)
+}
+
+// gridLayout is a copy of the layout of a grid, used to undo the changes of a breakpoint when it's deactivated.
+type gridLayout struct {
+	children   []*gridChild
+	placements map[*gridChild]gridPlacement
+
+	columnWidths    []int
+	rowHeights      []int
+	minColumnWidths []int
+	minRowHeights   []int
+	rowGap          int
+	columnGap       int
+	areas           map[string]Rect
 }
 
 type Grid struct {
@@ -29,9 +125,21 @@ type Grid struct {
 	prevWidth   int
 	prevHeight  int
 	forceResize bool
+	prevFocused *gridChild
+
+	columnWidths    []int
+	rowHeights      []int
+	minColumnWidths []int
+	minRowHeights   []int
+	rowGap          int
+	columnGap       int
+	areas           map[string]Rect
 
-	columnWidths []int
-	rowHeights   []int
+	breakpoints []*gridBreakpoint
+	breakpoint  *gridBreakpoint
+	// The layouts from before and after the active breakpoint was applied.
+	baseLayout    *gridLayout
+	appliedLayout *gridLayout
 
 	onFocusChanged func(from, to Component)
 }
@@ -103,35 +211,208 @@ func (grid *Grid) RemoveComponent(comp Component) *Grid {
 			grid.children = append(grid.children[:index], grid.children[index+1:]...)
 		}
 	}
+	grid.forceResize = true
 	return grid
 }
 
+// SetColumn sets the width of a column. Positive widths are fixed, negative widths are proportional,
+// and AutoSize makes the column as wide as the widest component that's only in that column (see Sizer).
 func (grid *Grid) SetColumn(col, width int) *Grid {
 	if col >= len(grid.columnWidths) {
 		grid.columnWidths = extend(grid.columnWidths, col+1)
 	}
 	grid.columnWidths[col] = width
+	grid.forceResize = true
 	return grid
 }
 
+// SetRow sets the height of a row. Positive heights are fixed, negative heights are proportional,
+// and AutoSize makes the row as tall as the tallest component that's only in that row (see Sizer).
 func (grid *Grid) SetRow(row, height int) *Grid {
 	if row >= len(grid.rowHeights) {
 		grid.rowHeights = extend(grid.rowHeights, row+1)
 	}
 	grid.rowHeights[row] = height
+	grid.forceResize = true
 	return grid
 }
 
 func (grid *Grid) SetColumns(columns []int) *Grid {
 	grid.columnWidths = columns
+	grid.forceResize = true
 	return grid
 }
 
 func (grid *Grid) SetRows(rows []int) *Grid {
 	grid.rowHeights = rows
+	grid.forceResize = true
+	return grid
+}
+
+// SetMinColumnWidth sets the minimum width of a column. Proportional and auto-sized columns are never made
+// narrower than their minimum, even if the grid is too small to fit all columns.
+func (grid *Grid) SetMinColumnWidth(col, width int) *Grid {
+	for len(grid.minColumnWidths) <= col {
+		grid.minColumnWidths = append(grid.minColumnWidths, 0)
+	}
+	grid.minColumnWidths[col] = width
+	grid.forceResize = true
 	return grid
 }
 
+// SetMinRowHeight sets the minimum height of a row. Proportional and auto-sized rows are never made
+// shorter than their minimum, even if the grid is too small to fit all rows.
+func (grid *Grid) SetMinRowHeight(row, height int) *Grid {
+	for len(grid.minRowHeights) <= row {
+		grid.minRowHeights = append(grid.minRowHeights, 0)
+	}
+	grid.minRowHeights[row] = height
+	grid.forceResize = true
+	return grid
+}
+
+// SetGap sets the number of empty cells between rows and between columns. Components spanning multiple
+// rows or columns also cover the gaps between them.
+func (grid *Grid) SetGap(rowGap, columnGap int) *Grid {
+	grid.rowGap = rowGap
+	grid.columnGap = columnGap
+	grid.forceResize = true
+	return grid
+}
+
+// SetComponentPadding sets the number of empty cells between the edges of the component's cell area and the component.
+func (grid *Grid) SetComponentPadding(comp Component, top, bottom, left, right int) *Grid {
+	if child := grid.findChild(comp); child != nil {
+		child.paddingTop, child.paddingBottom = top, bottom
+		child.paddingLeft, child.paddingRight = left, right
+		grid.forceResize = true
+	}
+	return grid
+}
+
+// SetComponentAlignment sets how the component is positioned inside its cell area. The alignments work like
+// in Flex: FlexStretch (the default) fills the area, while the others give the component its preferred size
+// (see Sizer) and position it at the start, center or end of the area. Components that don't implement Sizer
+// always fill the area.
+func (grid *Grid) SetComponentAlignment(comp Component, horizontal, vertical FlexAlignment) *Grid {
+	if child := grid.findChild(comp); child != nil {
+		child.horizontalAlign, child.verticalAlign = horizontal, vertical
+		grid.forceResize = true
+	}
+	return grid
+}
+
+// AddBreakpoint adds a function that changes the layout of the grid while it's narrower than the given width
+// or shorter than the given height. Zero disables the check for that dimension.
+//
+// The function can call any of the grid's layout methods, e.g. SetColumns, AddComponent or RemoveComponent.
+// When the breakpoint stops matching, the changes made by the function are undone. Changes made to the layout
+// while the breakpoint was active are kept, unless they were overwritten by undoing the breakpoint. If multiple
+// breakpoints match, only the last added one is applied, so breakpoints should be added from the largest to the smallest.
+func (grid *Grid) AddBreakpoint(width, height int, apply func(grid *Grid)) *Grid {
+	grid.breakpoints = append(grid.breakpoints, &gridBreakpoint{width: width, height: height, apply: apply})
+	grid.forceResize = true
+	return grid
+}
+
+func (grid *Grid) saveLayout() *gridLayout {
+	layout := &gridLayout{
+		children:        slices.Clone(grid.children),
+		placements:      make(map[*gridChild]gridPlacement, len(grid.children)),
+		columnWidths:    slices.Clone(grid.columnWidths),
+		rowHeights:      slices.Clone(grid.rowHeights),
+		minColumnWidths: slices.Clone(grid.minColumnWidths),
+		minRowHeights:   slices.Clone(grid.minRowHeights),
+		rowGap:          grid.rowGap,
+		columnGap:       grid.columnGap,
+		areas:           maps.Clone(grid.areas),
+	}
+	for _, child := range grid.children {
+		layout.placements[child] = child.placement()
+	}
+	return layout
+}
+
+// restoreLayout undoes the differences between the base and applied layouts. Anything that was changed after the
+// applied layout was saved is left as is.
+func (grid *Grid) restoreLayout(base, applied *gridLayout) {
+	children := make([]*gridChild, 0, len(grid.children))
+	for _, child := range base.children {
+		placement, keptByBreakpoint := applied.placements[child]
+		if keptByBreakpoint && !slices.Contains(grid.children, child) {
+			// The component was removed while the breakpoint was active.
+			continue
+		} else if !keptByBreakpoint || child.placement() == placement {
+			child.setPlacement(base.placements[child])
+		}
+		children = append(children, child)
+	}
+	for _, child := range grid.children {
+		_, inBase := base.placements[child]
+		_, inApplied := applied.placements[child]
+		if !inBase && !inApplied {
+			children = append(children, child)
+		}
+	}
+	grid.children = children
+
+	if slices.Equal(grid.columnWidths, applied.columnWidths) {
+		grid.columnWidths = slices.Clone(base.columnWidths)
+	}
+	if slices.Equal(grid.rowHeights, applied.rowHeights) {
+		grid.rowHeights = slices.Clone(base.rowHeights)
+	}
+	if slices.Equal(grid.minColumnWidths, applied.minColumnWidths) {
+		grid.minColumnWidths = slices.Clone(base.minColumnWidths)
+	}
+	if slices.Equal(grid.minRowHeights, applied.minRowHeights) {
+		grid.minRowHeights = slices.Clone(base.minRowHeights)
+	}
+	if grid.rowGap == applied.rowGap && grid.columnGap == applied.columnGap {
+		grid.rowGap, grid.columnGap = base.rowGap, base.columnGap
+	}
+	if maps.Equal(grid.areas, applied.areas) {
+		grid.areas = maps.Clone(base.areas)
+		grid.placeAreaComponents()
+	}
+	// Components added while the breakpoint was active may not fit in the restored rows and columns.
+	for _, child := range grid.children {
+		if child.relX+child.relWidth > len(grid.columnWidths) {
+			grid.columnWidths = extend(grid.columnWidths, child.relX+child.relWidth)
+		}
+		if child.relY+child.relHeight > len(grid.rowHeights) {
+			grid.rowHeights = extend(grid.rowHeights, child.relY+child.relHeight)
+		}
+	}
+	if grid.focused != nil && !slices.Contains(grid.children, grid.focused) {
+		grid.setFocused(nil)
+	}
+}
+
+// applyBreakpoint switches to the layout of the breakpoint matching the given size, if it isn't active already.
+func (grid *Grid) applyBreakpoint(width, height int) {
+	var active *gridBreakpoint
+	for _, breakpoint := range grid.breakpoints {
+		if (breakpoint.width > 0 && width < breakpoint.width) || (breakpoint.height > 0 && height < breakpoint.height) {
+			active = breakpoint
+		}
+	}
+	if active == grid.breakpoint {
+		return
+	}
+	if grid.baseLayout != nil {
+		grid.restoreLayout(grid.baseLayout, grid.appliedLayout)
+		grid.baseLayout, grid.appliedLayout = nil, nil
+	}
+	grid.breakpoint = active
+	if active != nil {
+		grid.baseLayout = grid.saveLayout()
+		active.apply(grid)
+		grid.appliedLayout = grid.saveLayout()
+	}
+	grid.forceResize = true
+}
+
 func pnSum(arr []int) (int, int) {
 	positive := 0
 	negative := 0
@@ -166,42 +447,136 @@ func fillDynamic(arr []int, size, dynamicItems int) []int {
 	return newArr
 }
 
+// fillTracks returns the sizes of rows or columns when the proportional ones share the given total size.
+// Proportional tracks below their minimum size are set to the minimum and the rest of the space is shared
+// among the others, so the result may be larger than the total size. Fixed tracks are never changed.
+func fillTracks(sizes, minSizes []int, size int) []int {
+	sizes = slices.Clone(sizes)
+	for {
+		fixed, dynamic := pnSum(sizes)
+		filled := fillDynamic(sizes, max(size-fixed, 0), dynamic)
+		frozen := false
+		for i, minSize := range minSizes {
+			if i < len(filled) && sizes[i] < 0 && filled[i] < minSize {
+				sizes[i] = minSize
+				frozen = true
+			}
+		}
+		if !frozen {
+			return filled
+		}
+	}
+}
+
+// trackSpan returns the offset and size of a span of rows or columns, including the gaps inside the span.
+// The part of the span beyond the last track is ignored.
+func trackSpan(sizes []int, start, length, gap int) (int, int) {
+	start = min(start, len(sizes))
+	length = min(length, len(sizes)-start)
+	offset, _ := pnSum(sizes[:start])
+	size, _ := pnSum(sizes[start : start+length])
+	return offset + start*gap, size + max(length-1, 0)*gap
+}
+
+// resolveAutoSizes returns a copy of the sizes where AutoSize entries are replaced with the largest size returned
+// by the measure function for the children, but at least the minimum size, or -1 if none of the children
+// implement Sizer.
+func (grid *Grid) resolveAutoSizes(sizes, minSizes []int, measure func(child *gridChild, index int) (int, bool)) []int {
+	resolved := make([]int, len(sizes))
+	copy(resolved, sizes)
+	for index, size := range sizes {
+		if size != AutoSize {
+			continue
+		}
+		resolved[index] = -1
+		for _, child := range grid.children {
+			if childSize, ok := measure(child, index); ok {
+				resolved[index] = max(resolved[index], childSize)
+			}
+		}
+		if resolved[index] >= 0 && index < len(minSizes) {
+			resolved[index] = max(resolved[index], minSizes[index])
+		}
+	}
+	return resolved
+}
+
+func (grid *Grid) hasAutoSizes() bool {
+	return slices.Contains(grid.columnWidths, AutoSize) || slices.Contains(grid.rowHeights, AutoSize)
+}
+
 func (grid *Grid) OnResize(width, height int) {
-	absColWidth, dynamicColumns := pnSum(grid.columnWidths)
-	columnWidths := fillDynamic(grid.columnWidths, width-absColWidth, dynamicColumns)
-	absRowHeight, dynamicRows := pnSum(grid.rowHeights)
-	rowHeights := fillDynamic(grid.rowHeights, height-absRowHeight, dynamicRows)
+	grid.applyBreakpoint(width, height)
+	resolvedColumns := grid.resolveAutoSizes(grid.columnWidths, grid.minColumnWidths, func(child *gridChild, col int) (int, bool) {
+		if child.relX != col || child.relWidth != 1 {
+			return 0, false
+		}
+		childWidth, _, ok := preferredSize(child.target, width, height)
+		return childWidth + child.paddingLeft + child.paddingRight, ok
+	})
+	columnWidths := fillTracks(resolvedColumns, grid.minColumnWidths, width-grid.columnGap*(len(resolvedColumns)-1))
+	resolvedRows := grid.resolveAutoSizes(grid.rowHeights, grid.minRowHeights, func(child *gridChild, row int) (int, bool) {
+		if child.relY != row || child.relHeight != 1 {
+			return 0, false
+		}
+		_, childWidth := trackSpan(columnWidths, child.relX, child.relWidth, grid.columnGap)
+		childWidth = max(childWidth-child.paddingLeft-child.paddingRight, 0)
+		_, childHeight, ok := preferredSize(child.target, childWidth, height)
+		return childHeight + child.paddingTop + child.paddingBottom, ok
+	})
+	rowHeights := fillTracks(resolvedRows, grid.minRowHeights, height-grid.rowGap*(len(resolvedRows)-1))
 	for _, child := range grid.children {
-		child.screen.OffsetX, _ = pnSum(columnWidths[:child.relX])
-		child.screen.OffsetY, _ = pnSum(rowHeights[:child.relY])
-		child.screen.Width, _ = pnSum(columnWidths[child.relX : child.relX+child.relWidth])
-		child.screen.Height, _ = pnSum(rowHeights[child.relY : child.relY+child.relHeight])
+		x, childWidth := trackSpan(columnWidths, child.relX, child.relWidth, grid.columnGap)
+		y, childHeight := trackSpan(rowHeights, child.relY, child.relHeight, grid.rowGap)
+		child.place(x, y, childWidth, childHeight)
 	}
 	grid.prevWidth, grid.prevHeight = width, height
 }
 
+func (grid *Grid) drawsPartially() {}
+
 func (grid *Grid) Draw(screen Screen) {
 	width, height := screen.Size()
-	if grid.forceResize || grid.prevWidth != width || grid.prevHeight != height {
+	// Breakpoints can add and remove children, so they have to be applied before the previous areas are saved.
+	grid.applyBreakpoint(width, height)
+	// Children may overlap, so a focus change requires a full redraw to get the order right.
+	partial := isPartialDraw(screen) && grid.focused == grid.prevFocused
+	if resized := grid.forceResize || grid.prevWidth != width || grid.prevHeight != height; resized || grid.hasAutoSizes() {
+		prevAreas := make([]Rect, len(grid.children))
+		for i, child := range grid.children {
+			prevAreas[i] = child.screen.area()
+		}
 		grid.OnResize(screen.Size())
+		for i, child := range grid.children {
+			// Auto-sized rows and columns can change the layout without anything else changing.
+			resized = resized || child.screen.area() != prevAreas[i]
+		}
+		if resized {
+			partial = false
+		}
 	}
 	grid.forceResize = false
-	screen.Clear()
+	grid.prevFocused = grid.focused
 	screenChanged := false
 	if screen != grid.screen {
 		grid.screen = screen
 		screenChanged = true
+		partial = false
 	}
+	if !partial {
+		screen.Clear()
+	}
+	clearStyle := proxyStyle(screen)
 	for _, child := range grid.children {
 		if screenChanged {
 			child.screen.Parent = screen
 		}
 		if grid.focused == nil || child != grid.focused {
-			child.target.Draw(child.screen)
+			drawChild(child.target, child.screen, partial, clearStyle)
 		}
 	}
 	if grid.focused != nil {
-		grid.focused.target.Draw(grid.focused.screen)
+		drawChild(grid.focused.target, grid.focused.screen, partial, clearStyle)
 	}
 }
 
@@ -219,6 +594,27 @@ func (grid *Grid) OnPasteEvent(event PasteEvent) bool {
 	return false
 }
 
+// SetFocused moves the focus to the given child component, blurring the previously focused child first.
+// If the component is nil, the focus is cleared. Components that aren't children of the grid are ignored.
+func (grid *Grid) SetFocused(comp Component) *Grid {
+	if comp == nil {
+		if grid.focused != nil {
+			grid.setFocused(nil)
+		}
+	} else if child := grid.findChild(comp); child != nil && child != grid.focused {
+		grid.setFocused(child)
+	}
+	return grid
+}
+
+// GetFocused returns the child component that currently has focus, or nil if no child is focused.
+func (grid *Grid) GetFocused() Component {
+	if grid.focused != nil {
+		return grid.focused.target
+	}
+	return nil
+}
+
 func (grid *Grid) setFocused(item *gridChild) {
 	if grid.focused != nil {
 		grid.focused.Blur()
@@ -238,6 +634,46 @@ func (grid *Grid) setFocused(item *gridChild) {
 		grid.onFocusChanged(prevFocus, newFocus)
 	}
 }
+
+func (grid *Grid) findChild(comp Component) *gridChild {
+	for _, child := range grid.children {
+		if child.target == comp {
+			return child
+		}
+	}
+	return nil
+}
+
+// Children returns the child components of the grid in the order they were added.
+func (grid *Grid) Children() []Component {
+	comps := make([]Component, len(grid.children))
+	for i, child := range grid.children {
+		comps[i] = child.target
+	}
+	return comps
+}
+
+func (grid *Grid) focusedChild() Component {
+	return grid.GetFocused()
+}
+
+func (grid *Grid) focusChild(comp Component) bool {
+	if grid.findChild(comp) == nil {
+		return false
+	}
+	grid.SetFocused(comp)
+	return true
+}
+
+// ChildArea returns the area of the given child relative to the grid, as of the last draw.
+func (grid *Grid) ChildArea(comp Component) (Rect, bool) {
+	child := grid.findChild(comp)
+	if child == nil {
+		return Rect{}, false
+	}
+	return child.screen.area(), true
+}
+
 func (grid *Grid) OnMouseEvent(event MouseEvent) bool {
 	if grid.focused != nil && grid.focused.screen.IsInArea(event.Position()) {
 		screen := grid.focused.screen
@@ -0,0 +1,193 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+)
+
+func gridAreas(grid *Grid) []Rect {
+	areas := make([]Rect, len(grid.children))
+	for i, child := range grid.children {
+		areas[i] = child.screen.area()
+	}
+	return areas
+}
+
+func TestGrid_GapsAndPadding(t *testing.T) {
+	a, b, c := NewTextView(), NewTextView(), NewButton("OK")
+	grid := NewGrid().SetColumns([]int{-1, -1}).SetRows([]int{-1, -1}).
+		AddComponent(a, 0, 0, 1, 1).
+		AddComponent(b, 1, 0, 1, 2).
+		AddComponent(c, 0, 1, 1, 1).
+		SetGap(1, 2).
+		SetComponentPadding(a, 1, 1, 2, 2).
+		SetComponentAlignment(c, FlexCenter, FlexEnd)
+	grid.OnResize(22, 11)
+	expected := []Rect{{2, 1, 6, 3}, {12, 0, 10, 11}, {3, 10, 4, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
+
+func TestGrid_MinSizes(t *testing.T) {
+	grid := NewGrid().SetColumns([]int{-1, -1, 4}).
+		AddComponent(NewTextView(), 0, 0, 1, 1).
+		AddComponent(NewTextView(), 1, 0, 1, 1).
+		AddComponent(NewTextView(), 2, 0, 1, 1).
+		SetMinColumnWidth(0, 10).
+		SetMinColumnWidth(2, 8)
+	grid.OnResize(20, 1)
+	// The fixed column keeps its size even though its minimum is larger.
+	expected := []Rect{{0, 0, 10, 1}, {10, 0, 6, 1}, {16, 0, 4, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+	grid.OnResize(12, 1)
+	expected = []Rect{{0, 0, 10, 1}, {10, 0, 0, 1}, {10, 0, 4, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected the proportional column to stay at its minimum, got %v", areas)
+	}
+
+	auto := NewGrid().SetColumns([]int{AutoSize, -1}).
+		AddComponent(NewButton("OK"), 0, 0, 1, 1).
+		AddComponent(NewTextView(), 1, 0, 1, 1).
+		SetMinColumnWidth(0, 6)
+	auto.OnResize(20, 1)
+	expected = []Rect{{0, 0, 6, 1}, {6, 0, 14, 1}}
+	if areas := gridAreas(auto); !slices.Equal(areas, expected) {
+		t.Errorf("expected the auto-sized column to be widened to its minimum, got %v", areas)
+	}
+}
+
+func TestGrid_Breakpoint(t *testing.T) {
+	a, b := NewTextView(), NewTextView()
+	grid := NewGrid().SetColumns([]int{-1, -1}).
+		AddComponent(a, 0, 0, 1, 1).
+		AddComponent(b, 1, 0, 1, 1).
+		SetGap(0, 2).
+		AddBreakpoint(20, 0, func(grid *Grid) {
+			grid.SetColumns([]int{-1}).SetGap(0, 0).RemoveComponent(b)
+		})
+	grid.OnResize(10, 1)
+	if children := grid.Children(); !slices.Equal(children, []Component{a}) {
+		t.Fatalf("expected the breakpoint to remove a component, got %v", children)
+	}
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{0, 0, 10, 1}}) {
+		t.Errorf("expected the breakpoint layout to be used, got %v", areas)
+	}
+	grid.OnResize(30, 1)
+	if children := grid.Children(); !slices.Equal(children, []Component{a, b}) {
+		t.Fatalf("expected the removed component to be restored, got %v", children)
+	}
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{0, 0, 14, 1}, {16, 0, 14, 1}}) {
+		t.Errorf("expected the original layout to be restored, got %v", areas)
+	}
+}
+
+func TestGrid_BreakpointKeepsOtherChanges(t *testing.T) {
+	a, b, c := NewTextView(), NewTextView(), NewTextView()
+	added := NewTextView()
+	grid := NewGrid().SetColumns([]int{-1, -1}).
+		AddComponent(a, 0, 0, 1, 1).
+		AddComponent(b, 1, 0, 1, 1).
+		AddComponent(c, 0, 1, 1, 1).
+		AddBreakpoint(20, 0, func(grid *Grid) {
+			grid.SetColumns([]int{-1}).RemoveComponent(b).
+				AddComponent(added, 0, 2, 1, 1)
+		})
+	grid.OnResize(10, 3)
+	// Changes made while the breakpoint is active are kept when it's deactivated.
+	extra := NewTextView()
+	grid.RemoveComponent(c).AddComponent(extra, 0, 3, 1, 1).SetRows([]int{1, 1, 1, 1})
+	grid.SetComponentPadding(a, 0, 0, 1, 0)
+	grid.OnResize(30, 4)
+	if children := grid.Children(); !slices.Equal(children, []Component{a, b, extra}) {
+		t.Fatalf("expected only the breakpoint's changes to be undone, got %v", children)
+	}
+	if !slices.Equal(grid.rowHeights, []int{1, 1, 1, 1}) || !slices.Equal(grid.columnWidths, []int{-1, -1}) {
+		t.Errorf("unexpected rows %v and columns %v", grid.rowHeights, grid.columnWidths)
+	}
+	expected := []Rect{{1, 0, 14, 1}, {15, 0, 15, 1}, {0, 3, 15, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
+
+func TestGrid_BreakpointTemplate(t *testing.T) {
+	side, main, status := NewTextView(), NewTextView(), NewTextView()
+	grid := NewGrid().
+		MustSetTemplate([]string{"side main"}, []int{10, -1}, []int{-1}).
+		MustAddAreaComponent(side, "side").
+		MustAddAreaComponent(main, "main").
+		AddBreakpoint(20, 0, func(grid *Grid) {
+			grid.MustSetTemplate([]string{"main", "side"}, []int{-1}, []int{-1, 2})
+		})
+	grid.OnResize(10, 6)
+	// Components added to an area while the breakpoint is active move along when the template is restored.
+	grid.MustAddAreaComponent(status, "side")
+	grid.OnResize(30, 6)
+	if area, _ := grid.GetArea("main"); area != (Rect{X: 1, Y: 0, Width: 1, Height: 1}) {
+		t.Errorf("expected the original template to be restored, got %v", area)
+	}
+	expected := []Rect{{0, 0, 10, 6}, {10, 0, 20, 6}, {0, 0, 10, 6}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
+
+func TestGrid_BreakpointCopiesLayout(t *testing.T) {
+	main := NewTextView()
+	grid := NewGrid().
+		MustSetTemplate([]string{"side main"}, nil, nil).
+		MustAddAreaComponent(main, "main").
+		AddBreakpoint(20, 0, func(grid *Grid) {
+			// Modifying the current layout in place must not change the saved copy.
+			grid.areas["main"] = Rect{X: 0, Y: 0, Width: 2, Height: 1}
+			grid.columnWidths[0] = 2
+			grid.placeAreaComponents()
+		})
+	grid.OnResize(10, 1)
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{0, 0, 10, 1}}) {
+		t.Errorf("expected the breakpoint's area to be used, got %v", areas)
+	}
+	grid.OnResize(30, 1)
+	if area, _ := grid.GetArea("main"); area != (Rect{X: 1, Y: 0, Width: 1, Height: 1}) {
+		t.Errorf("expected the original area to be restored, got %v", area)
+	}
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{15, 0, 15, 1}}) {
+		t.Errorf("expected the component to move back to its original area, got %v", areas)
+	}
+}
+
+// uncomparableComponent is a component whose type can't be compared with ==.
+type uncomparableComponent struct {
+	*TextView
+	lines []string
+}
+
+func TestGrid_BreakpointWithUncomparableComponent(t *testing.T) {
+	comp := uncomparableComponent{TextView: NewTextView()}
+	grid := NewGrid().SetColumns([]int{-1, -1}).
+		AddComponent(comp, 1, 0, 1, 1).
+		AddBreakpoint(20, 0, func(grid *Grid) {
+			// Looking up the component would compare it, so the placement is changed directly.
+			grid.SetColumns([]int{-1})
+			grid.children[0].relX = 0
+			grid.children[0].paddingLeft, grid.children[0].paddingRight = 1, 1
+		})
+	grid.OnResize(10, 1)
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{1, 0, 8, 1}}) {
+		t.Errorf("expected the breakpoint layout to be used, got %v", areas)
+	}
+	grid.OnResize(30, 1)
+	if areas := gridAreas(grid); !slices.Equal(areas, []Rect{{15, 0, 15, 1}}) {
+		t.Errorf("expected the original placement to be restored, got %v", areas)
+	}
+}
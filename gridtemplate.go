@@ -0,0 +1,158 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidTemplate    = errors.New("invalid grid template")
+	ErrNonRectangularArea = errors.New("grid template area is not rectangular")
+	ErrUnknownArea        = errors.New("unknown grid template area")
+)
+
+// parseGridTemplate returns the named areas defined by the rows of a grid template and the number of columns.
+func parseGridTemplate(rows []string) (map[string]Rect, int, error) {
+	cells := make([][]string, len(rows))
+	for y, row := range rows {
+		cells[y] = strings.Fields(row)
+		if len(cells[y]) == 0 {
+			return nil, 0, fmt.Errorf("%w: row %d is empty", ErrInvalidTemplate, y+1)
+		} else if len(cells[y]) != len(cells[0]) {
+			return nil, 0, fmt.Errorf("%w: row %d has %d columns, but row 1 has %d",
+				ErrInvalidTemplate, y+1, len(cells[y]), len(cells[0]))
+		}
+	}
+	if len(cells) == 0 {
+		return nil, 0, fmt.Errorf("%w: no rows", ErrInvalidTemplate)
+	}
+	areas := make(map[string]Rect)
+	for y, row := range cells {
+		for x, name := range row {
+			if name != "." {
+				areas[name] = areas[name].Union(Rect{X: x, Y: y, Width: 1, Height: 1})
+			}
+		}
+	}
+	// Every cell inside the bounding box of an area must belong to that area. Each cell only has one name,
+	// so areas can't overlap, and a different name inside the box means the area is L-shaped or disconnected.
+	for _, name := range slices.Sorted(maps.Keys(areas)) {
+		area := areas[name]
+		for y := area.Y; y < area.Y+area.Height; y++ {
+			for x := area.X; x < area.X+area.Width; x++ {
+				if other := cells[y][x]; other != name {
+					return nil, 0, fmt.Errorf("%w: %q has a cell of %q in row %d, column %d",
+						ErrNonRectangularArea, name, other, y+1, x+1)
+				}
+			}
+		}
+	}
+	return areas, len(cells[0]), nil
+}
+
+// SetTemplate defines named areas of the grid from a template similar to grid-template-areas in CSS.
+// Each string is a row of the grid, containing the whitespace-separated names of the areas its columns belong to,
+// or a dot for cells that don't belong to any area. Each area must be a rectangle:
+//
+//	grid.SetTemplate([]string{
+//		"header header",
+//		"list   main",
+//		".      input",
+//	}, []int{20, -1}, []int{1, -1, 3})
+//
+// The column widths and row heights work like in SetColumns and SetRows. If they're nil, the existing sizes are
+// kept, and extended with proportional sizes if the template is larger.
+//
+// Components placed with AddAreaComponent are moved to the area with the same name in the new template,
+// so the template can be changed later, e.g. in a breakpoint (see AddBreakpoint). If an area used by a component
+// is missing from the new template, the grid isn't changed and an error wrapping ErrUnknownArea is returned.
+// Likewise, the column widths and row heights must cover the components placed with AddComponent, or an error
+// wrapping ErrInvalidTemplate is returned.
+func (grid *Grid) SetTemplate(rows []string, columnWidths, rowHeights []int) error {
+	areas, columns, err := parseGridTemplate(rows)
+	if err != nil {
+		return err
+	} else if columnWidths != nil && len(columnWidths) != columns {
+		return fmt.Errorf("%w: got %d column widths for %d columns", ErrInvalidTemplate, len(columnWidths), columns)
+	} else if rowHeights != nil && len(rowHeights) != len(rows) {
+		return fmt.Errorf("%w: got %d row heights for %d rows", ErrInvalidTemplate, len(rowHeights), len(rows))
+	}
+	for _, child := range grid.children {
+		if _, ok := areas[child.area]; child.area != "" && !ok {
+			return fmt.Errorf("%w %q is used by a component", ErrUnknownArea, child.area)
+		} else if child.area == "" && columnWidths != nil && child.relX+child.relWidth > len(columnWidths) {
+			return fmt.Errorf("%w: a component covers %d columns, but the template only has %d",
+				ErrInvalidTemplate, child.relX+child.relWidth, len(columnWidths))
+		} else if child.area == "" && rowHeights != nil && child.relY+child.relHeight > len(rowHeights) {
+			return fmt.Errorf("%w: a component covers %d rows, but the template only has %d",
+				ErrInvalidTemplate, child.relY+child.relHeight, len(rowHeights))
+		}
+	}
+	if columnWidths != nil {
+		grid.columnWidths = columnWidths
+	} else if columns > len(grid.columnWidths) {
+		grid.columnWidths = extend(grid.columnWidths, columns)
+	}
+	if rowHeights != nil {
+		grid.rowHeights = rowHeights
+	} else if len(rows) > len(grid.rowHeights) {
+		grid.rowHeights = extend(grid.rowHeights, len(rows))
+	}
+	grid.areas = areas
+	grid.placeAreaComponents()
+	grid.forceResize = true
+	return nil
+}
+
+// placeAreaComponents moves the components placed with AddAreaComponent to their areas in the current template.
+func (grid *Grid) placeAreaComponents() {
+	for _, child := range grid.children {
+		if area, ok := grid.areas[child.area]; ok && child.area != "" {
+			child.relX, child.relY, child.relWidth, child.relHeight = area.X, area.Y, area.Width, area.Height
+		}
+	}
+}
+
+// MustSetTemplate calls SetTemplate and panics if it fails. It's meant for templates defined in code.
+func (grid *Grid) MustSetTemplate(rows []string, columnWidths, rowHeights []int) *Grid {
+	if err := grid.SetTemplate(rows, columnWidths, rowHeights); err != nil {
+		panic(fmt.Errorf("failed to set grid template: %w", err))
+	}
+	return grid
+}
+
+// GetArea returns the columns and rows covered by the named area of the current template.
+func (grid *Grid) GetArea(name string) (Rect, bool) {
+	area, ok := grid.areas[name]
+	return area, ok
+}
+
+// AddAreaComponent adds a component that covers the named area of the current template (see SetTemplate).
+func (grid *Grid) AddAreaComponent(comp Component, area string) error {
+	rect, ok := grid.areas[area]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownArea, area)
+	}
+	child := grid.createChild(comp, rect.X, rect.Y, rect.Width, rect.Height)
+	child.area = area
+	grid.addChild(child)
+	return nil
+}
+
+// MustAddAreaComponent calls AddAreaComponent and panics if it fails.
+func (grid *Grid) MustAddAreaComponent(comp Component, area string) *Grid {
+	if err := grid.AddAreaComponent(comp, area); err != nil {
+		panic(fmt.Errorf("failed to add component to grid: %w", err))
+	}
+	return grid
+}
@@ -0,0 +1,103 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestParseGridTemplate(t *testing.T) {
+	areas, columns, err := parseGridTemplate([]string{"a a b", "c c b", ". . b"})
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	expected := map[string]Rect{
+		"a": {X: 0, Y: 0, Width: 2, Height: 1},
+		"b": {X: 2, Y: 0, Width: 1, Height: 3},
+		"c": {X: 0, Y: 1, Width: 2, Height: 1},
+	}
+	if columns != 3 || !maps.Equal(areas, expected) {
+		t.Errorf("expected %d columns with areas %v, got %d with %v", 3, expected, columns, areas)
+	}
+
+	for _, test := range []struct {
+		rows     []string
+		expected error
+	}{
+		{nil, ErrInvalidTemplate},
+		{[]string{"a b", ""}, ErrInvalidTemplate},
+		{[]string{"a b", "a"}, ErrInvalidTemplate},
+		{[]string{"a .", "a a"}, ErrNonRectangularArea},
+		{[]string{"a a", "a b"}, ErrNonRectangularArea},
+		{[]string{"a b a"}, ErrNonRectangularArea},
+	} {
+		if _, _, err := parseGridTemplate(test.rows); !errors.Is(err, test.expected) {
+			t.Errorf("expected template %q to fail with %v, got %v", test.rows, test.expected, err)
+		}
+	}
+}
+
+func TestGrid_Template(t *testing.T) {
+	header, list, main := NewTextView(), NewTextView(), NewTextView()
+	grid := NewGrid().
+		MustSetTemplate([]string{"header header", "list main"}, []int{5, -1}, []int{1, -1}).
+		MustAddAreaComponent(header, "header").
+		MustAddAreaComponent(list, "list").
+		MustAddAreaComponent(main, "main")
+	grid.OnResize(20, 6)
+	expected := []Rect{{0, 0, 20, 1}, {0, 1, 5, 5}, {5, 1, 15, 5}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+	if err := grid.AddAreaComponent(NewTextView(), "footer"); !errors.Is(err, ErrUnknownArea) {
+		t.Errorf("expected adding a component to a missing area to fail, got %v", err)
+	}
+	if err := grid.SetTemplate([]string{"header", "main"}, nil, nil); !errors.Is(err, ErrUnknownArea) {
+		t.Errorf("expected removing an area that's in use to fail, got %v", err)
+	}
+
+	// Changing the template moves the components to the new areas.
+	grid.MustSetTemplate([]string{"header", "main", "list"}, []int{-1}, []int{1, -1, 2})
+	grid.OnResize(20, 6)
+	expected = []Rect{{0, 0, 20, 1}, {0, 4, 20, 2}, {0, 1, 20, 3}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
+
+func TestGrid_TemplateWithPlainComponents(t *testing.T) {
+	main, side := NewTextView(), NewTextView()
+	grid := NewGrid().
+		MustSetTemplate([]string{"main ."}, []int{-1, 10}, nil).
+		MustAddAreaComponent(main, "main").
+		AddComponent(side, 1, 0, 1, 1)
+	if err := grid.SetTemplate([]string{"main"}, []int{-1}, nil); !errors.Is(err, ErrInvalidTemplate) {
+		t.Errorf("expected a template without the columns of a component to fail, got %v", err)
+	}
+	if err := grid.SetTemplate([]string{"main", "."}, nil, []int{-1}); !errors.Is(err, ErrInvalidTemplate) {
+		t.Errorf("expected the row heights to have to match the template, got %v", err)
+	}
+	// Templates without explicit sizes keep the existing columns.
+	grid.MustSetTemplate([]string{"main"}, nil, nil)
+	grid.OnResize(20, 1)
+	expected := []Rect{{0, 0, 10, 1}, {10, 0, 10, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+
+	// Components outside the columns are cut off instead of crashing the layout.
+	grid.SetColumns([]int{-1})
+	grid.OnResize(20, 1)
+	expected = []Rect{{0, 0, 20, 1}, {20, 0, 0, 1}}
+	if areas := gridAreas(grid); !slices.Equal(areas, expected) {
+		t.Errorf("expected areas %v, got %v", expected, areas)
+	}
+}
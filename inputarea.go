@@ -52,8 +52,10 @@ type InputArea struct {
 	// The background color of selected text.
 	selectionBackgroundColor tcell.Color
 
-	// Whether or not to enable vim-style keybindings.
-	vimBindings bool
+	// The keymap used for key events, or nil to use InputAreaKeymap.
+	keymap *Keymap
+	// The state of a partially entered multi-key binding.
+	keyChord ChordState
 	// Whether or not text should be automatically copied to the primary clipboard when selected.
 	// Most apps on Linux work this way.
 	copySelection bool
@@ -115,7 +117,6 @@ func NewInputArea() *InputArea {
 		selectionTextColor:       Styles.PrimaryTextColor,
 		selectionBackgroundColor: Styles.ContrastBackgroundColor,
 
-		vimBindings:   false,
 		copySelection: true,
 		focused:       false,
 
@@ -215,6 +216,16 @@ func (field *InputArea) GetTextHeight() int {
 	return len(field.lines)
 }
 
+// PreferredSize returns the full available width and the number of lines the text takes at that width,
+// so that e.g. a message composer can grow with its content. The height is always at least one line.
+func (field *InputArea) PreferredSize(width, height int) (int, int) {
+	if width < 1 {
+		return 0, 1
+	}
+	field.prepareText(width)
+	return width, max(len(field.lines), 1)
+}
+
 // inputAreaSnapshot is a single history snapshot of the input area state.
 type inputAreaSnapshot struct {
 	text          string
@@ -981,93 +992,198 @@ func (field *InputArea) copy(selection string, cut bool) {
 	}
 }
 
+// InputAreaKeymap is the default keymap of input areas. It can be overridden per input area with SetKeymap.
+//
+// Characters that aren't bound to anything are typed into the input area. The backspace action removes either
+// the previous word or character depending on Backspace1RemovesWord and Backspace2RemovesWord.
+//
+// Arrow keys, Home and End with modifier combinations that aren't bound (e.g. Alt+Left or Ctrl+Shift+Home)
+// fall back to the movement actions: Ctrl moves by word, Shift extends the selection and other modifiers are
+// ignored. The fallback is only used for actions that are bound to some key in the keymap. Other keys with
+// modifier combinations that aren't bound (e.g. Shift+Enter or Ctrl+Delete) are handled like the same key
+// without modifiers.
+var InputAreaKeymap = newDefaultKeymap("input-area", []string{
+	"newline", "move-left", "move-right", "move-word-left", "move-word-right",
+	"select-left", "select-right", "select-word-left", "select-word-right",
+	"move-up", "move-down", "select-up", "select-down", "move-home", "move-end", "select-home", "select-end",
+	"delete-next", "backspace", "delete-previous-word", "delete-previous-character", "clear",
+	"tab-complete", "select-all", "undo", "redo", "copy", "paste", "cut",
+}, map[string]string{
+	"Enter":            "newline",
+	"Left":             "move-left",
+	"Right":            "move-right",
+	"Ctrl+Left":        "move-word-left",
+	"Ctrl+Right":       "move-word-right",
+	"Shift+Left":       "select-left",
+	"Shift+Right":      "select-right",
+	"Ctrl+Shift+Left":  "select-word-left",
+	"Ctrl+Shift+Right": "select-word-right",
+	"Up":               "move-up",
+	"Down":             "move-down",
+	"Shift+Up":         "select-up",
+	"Shift+Down":       "select-down",
+	"Home":             "move-home",
+	"End":              "move-end",
+	"Shift+Home":       "select-home",
+	"Shift+End":        "select-end",
+	"Delete":           "delete-next",
+	"Backspace":        "backspace",
+	"Backspace2":       "backspace",
+	"Tab":              "tab-complete",
+	"Ctrl+A":           "select-all",
+	"Ctrl+Z":           "undo",
+	"Ctrl+Y":           "redo",
+	"Ctrl+C":           "copy",
+	"Ctrl+V":           "paste",
+	"Ctrl+X":           "cut",
+})
+
+// InputAreaVimKeymap is an alternative keymap for input areas with readline-style
+// Ctrl+U and Ctrl+W bindings instead of the select all, undo/redo and clipboard bindings.
+var InputAreaVimKeymap = func() *Keymap {
+	km := InputAreaKeymap.Clone("input-area-vim")
+	for _, action := range []string{"select-all", "undo", "redo", "copy", "paste", "cut"} {
+		km.UnbindAction(action)
+	}
+	return RegisterKeymap(km.MustBind("Ctrl+U", "clear").MustBind("Ctrl+W", "delete-previous-word"))
+}()
+
+// SetKeymap sets the keymap used by this input area. If nil, InputAreaKeymap is used.
+func (field *InputArea) SetKeymap(keymap *Keymap) *InputArea {
+	field.keymap = keymap
+	field.keyChord.Reset()
+	return field
+}
+
+func (field *InputArea) getKeymap() *Keymap {
+	if field.keymap != nil {
+		return field.keymap
+	}
+	return InputAreaKeymap
+}
+
+// inputAreaMovementAction returns the movement action for an arrow, Home or End key based on its modifiers.
+func inputAreaMovementAction(event KeyEvent) string {
+	var direction string
+	switch event.Key() {
+	case tcell.KeyLeft:
+		direction = "left"
+	case tcell.KeyRight:
+		direction = "right"
+	case tcell.KeyUp:
+		direction = "up"
+	case tcell.KeyDown:
+		direction = "down"
+	case tcell.KeyHome:
+		direction = "home"
+	case tcell.KeyEnd:
+		direction = "end"
+	default:
+		return ""
+	}
+	action := "move-"
+	if event.Modifiers()&tcell.ModShift != 0 {
+		action = "select-"
+	}
+	if event.Modifiers()&tcell.ModCtrl != 0 && (direction == "left" || direction == "right") {
+		action += "word-"
+	}
+	return action + direction
+}
+
 // OnKeyEvent handles a terminal key press event.
 func (field *InputArea) OnKeyEvent(event KeyEvent) bool {
-	hasMod := func(mod tcell.ModMask) bool {
-		return event.Modifiers()&mod != 0
-	}
 	oldText := field.text
 
 	doSnapshot := false
 	forceNewSnapshot := false
-	// Process key event.
-	switch event.Key() {
-	case tcell.KeyRune:
+	keymap := field.getKeymap()
+	action, consumed := keymap.Process(&field.keyChord, event)
+	for _, kp := range field.keyChord.Abandoned() {
+		if kp.Key == tcell.KeyRune {
+			field.TypeRune(kp.Rune)
+			doSnapshot = true
+		}
+	}
+	if !consumed {
+		if fallback := inputAreaMovementAction(event); fallback != "" && len(keymap.KeysFor(fallback)) > 0 {
+			action, consumed = fallback, true
+		} else if fallback = keymap.lookupWithoutModifiers(event); fallback != "" {
+			action, consumed = fallback, true
+		}
+	}
+	if !consumed {
+		if event.Key() != tcell.KeyRune {
+			return false
+		}
 		field.TypeRune(event.Rune())
 		doSnapshot = true
 		forceNewSnapshot = event.Rune() == ' '
-	case tcell.KeyEnter:
+	}
+	// Process key event.
+	switch action {
+	case "newline":
 		field.TypeRune('\n')
 		doSnapshot = true
 		forceNewSnapshot = true
-	case tcell.KeyLeft:
-		field.MoveCursorLeft(hasMod(tcell.ModCtrl), hasMod(tcell.ModShift))
-	case tcell.KeyRight:
-		field.MoveCursorRight(hasMod(tcell.ModCtrl), hasMod(tcell.ModShift))
-	case tcell.KeyUp:
-		field.MoveCursorUp(hasMod(tcell.ModShift))
-	case tcell.KeyDown:
-		field.MoveCursorDown(hasMod(tcell.ModShift))
-	case tcell.KeyHome:
-		field.MoveCursorHome(hasMod(tcell.ModShift))
-	case tcell.KeyEnd:
-		field.MoveCursorEnd(hasMod(tcell.ModShift))
-	case tcell.KeyDelete:
+	case "move-left", "move-word-left", "select-left", "select-word-left":
+		field.MoveCursorLeft(strings.Contains(action, "word"), strings.HasPrefix(action, "select"))
+	case "move-right", "move-word-right", "select-right", "select-word-right":
+		field.MoveCursorRight(strings.Contains(action, "word"), strings.HasPrefix(action, "select"))
+	case "move-up", "select-up":
+		field.MoveCursorUp(action == "select-up")
+	case "move-down", "select-down":
+		field.MoveCursorDown(action == "select-down")
+	case "move-home", "select-home":
+		field.MoveCursorHome(action == "select-home")
+	case "move-end", "select-end":
+		field.MoveCursorEnd(action == "select-end")
+	case "delete-next":
 		field.RemoveNextCharacter()
 		doSnapshot = true
-	case tcell.KeyBackspace:
-		if Backspace1RemovesWord {
+	case "backspace":
+		removeWord := Backspace2RemovesWord
+		if event.Key() == tcell.KeyBackspace {
+			removeWord = Backspace1RemovesWord
+			forceNewSnapshot = true
+		} else {
+			forceNewSnapshot = field.selectionEndW > 0
+		}
+		if removeWord {
 			field.RemovePreviousWord()
 		} else {
 			field.RemovePreviousCharacter()
 		}
 		doSnapshot = true
+	case "delete-previous-word":
+		field.RemovePreviousWord()
+		doSnapshot = true
 		forceNewSnapshot = true
-	case tcell.KeyBackspace2:
+	case "delete-previous-character":
 		forceNewSnapshot = field.selectionEndW > 0
-		if Backspace2RemovesWord {
-			field.RemovePreviousWord()
-		} else {
-			field.RemovePreviousCharacter()
-		}
+		field.RemovePreviousCharacter()
+		doSnapshot = true
+	case "clear":
+		field.Clear()
 		doSnapshot = true
-	case tcell.KeyTab:
+		forceNewSnapshot = true
+	case "tab-complete":
 		if field.tabComplete != nil {
 			field.tabComplete(field.text, field.cursorOffsetW)
 		}
-	default:
-		if field.vimBindings {
-			switch event.Key() {
-			case tcell.KeyCtrlU:
-				field.Clear()
-				doSnapshot = true
-				forceNewSnapshot = true
-			case tcell.KeyCtrlW:
-				field.RemovePreviousWord()
-				doSnapshot = true
-				forceNewSnapshot = true
-			default:
-				return false
-			}
-		} else {
-			switch event.Key() {
-			case tcell.KeyCtrlA:
-				field.SelectAll()
-			case tcell.KeyCtrlZ:
-				field.Undo()
-			case tcell.KeyCtrlY:
-				field.Redo()
-			case tcell.KeyCtrlC:
-				field.Copy()
-			case tcell.KeyCtrlV:
-				field.Paste()
-				return true
-			case tcell.KeyCtrlX:
-				field.Cut()
-			default:
-				return false
-			}
-		}
+	case "select-all":
+		field.SelectAll()
+	case "undo":
+		field.Undo()
+	case "redo":
+		field.Redo()
+	case "copy":
+		field.Copy()
+	case "paste":
+		field.Paste()
+		return true
+	case "cut":
+		field.Cut()
 	}
 	field.handleInputChanges(oldText)
 	if doSnapshot {
@@ -0,0 +1,117 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func newTestInputArea(text string) *InputArea {
+	area := NewInputArea()
+	area.SetText(text)
+	// Don't touch the system clipboard when selecting text.
+	area.copySelection = false
+	area.PrepareDraw(20)
+	return area
+}
+
+func TestInputArea_ModifiedMovementKeys(t *testing.T) {
+	area := newTestInputArea("hello world\nsecond line")
+	area.SetCursorOffset(-1)
+
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModAlt))
+	if offset := area.GetCursorOffset(); offset != 22 {
+		t.Errorf("expected Alt+Left to move one character left to 22, got %d", offset)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModCtrl|tcell.ModAlt))
+	if offset := area.GetCursorOffset(); offset != 19 {
+		t.Errorf("expected Ctrl+Alt+Left to move one word left to 19, got %d", offset)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModCtrl))
+	if _, y := area.GetCursorPos(); y != 0 {
+		t.Errorf("expected Ctrl+Up to move to the first line, got line %d", y)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnd, 0, tcell.ModCtrl))
+	if offset := area.GetCursorOffset(); offset != 23 {
+		t.Errorf("expected Ctrl+End to move to the end of the text, got %d", offset)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyHome, 0, tcell.ModCtrl|tcell.ModShift))
+	if start, end := area.GetSelection(); start != 0 || end != 23 {
+		t.Errorf("expected Ctrl+Shift+Home to select everything, got %d-%d", start, end)
+	}
+	if text := area.GetText(); text != "hello world\nsecond line" {
+		t.Errorf("expected movement keys not to change the text, got %q", text)
+	}
+}
+
+func TestInputArea_MovementFallbackRespectsKeymap(t *testing.T) {
+	keymap := InputAreaKeymap.Clone("test")
+	keymap.UnbindAction("move-left")
+	area := newTestInputArea("abc")
+	area.SetKeymap(keymap)
+	area.SetCursorOffset(-1)
+	if area.OnKeyEvent(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModAlt)) {
+		t.Errorf("expected Alt+Left not to be handled when move-left isn't bound")
+	}
+	if offset := area.GetCursorOffset(); offset != 3 {
+		t.Errorf("expected cursor not to move, got %d", offset)
+	}
+}
+
+func TestInputArea_AbandonedChordIsTyped(t *testing.T) {
+	keymap := InputAreaKeymap.Clone("test").MustBind("j k", "move-left")
+	area := newTestInputArea("")
+	area.SetKeymap(keymap)
+
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone))
+	if text := area.GetText(); text != "" {
+		t.Fatalf("expected the chord prefix to be held back, got %q", text)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone))
+	if text := area.GetText(); text != "ja" {
+		t.Errorf("expected the abandoned chord prefix to be typed, got %q", text)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone))
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'k', tcell.ModNone))
+	if text, offset := area.GetText(), area.GetCursorOffset(); text != "ja" || offset != 1 {
+		t.Errorf("expected the completed chord to move the cursor, got %q with cursor at %d", text, offset)
+	}
+}
+
+func TestInputArea_ModifiedEditingKeys(t *testing.T) {
+	area := newTestInputArea("ab cd")
+	area.SetCursorOffset(-1)
+	for _, mod := range []tcell.ModMask{tcell.ModAlt, tcell.ModShift, tcell.ModCtrl} {
+		if !area.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, mod)) {
+			t.Errorf("expected Enter with modifiers %d to be handled", mod)
+		}
+	}
+	if text := area.GetText(); text != "ab cd\n\n\n" {
+		t.Fatalf("expected modified Enter keys to insert newlines, got %q", text)
+	}
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModAlt))
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModCtrl))
+	if text := area.GetText(); text != "ab cd\n" {
+		t.Errorf("expected modified Backspace keys to remove characters, got %q", text)
+	}
+	area.SetCursorOffset(0)
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModCtrl))
+	if text := area.GetText(); text != "b cd\n" {
+		t.Errorf("expected Ctrl+Delete to remove the next character, got %q", text)
+	}
+	completions := 0
+	area.SetTabCompleteFunc(func(text string, cursorOffset int) {
+		completions++
+	})
+	area.OnKeyEvent(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModAlt))
+	if completions != 1 {
+		t.Errorf("expected Alt+Tab to trigger tab completion")
+	}
+}
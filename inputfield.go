@@ -45,8 +45,10 @@ type InputField struct {
 	// disables masking.
 	maskCharacter rune
 
-	// Whether or not to enable vim-style keybindings.
-	vimBindings bool
+	// The keymap used for key events, or nil to use InputFieldKeymap.
+	keymap *Keymap
+	// The state of a partially entered multi-key binding.
+	keyChord ChordState
 
 	// Whether or not the input field is focused.
 	focused bool
@@ -356,47 +358,99 @@ var (
 	Backspace2RemovesWord = false
 )
 
+// InputFieldKeymap is the default keymap of input fields. It can be overridden per input field with SetKeymap.
+//
+// Characters that aren't bound to anything are typed into the input field. The backspace action removes either
+// the previous word or character depending on Backspace1RemovesWord and Backspace2RemovesWord.
+// Keys with modifier combinations that aren't bound (e.g. Alt+Left or Ctrl+Delete) are handled like the same key
+// without modifiers. The ignore action consumes the key without doing anything: it's bound to Ctrl+U and Ctrl+W,
+// so that they don't reach parent components regardless of whether the readline-style bindings are used.
+var InputFieldKeymap = newDefaultKeymap("input-field", []string{
+	"move-left", "move-right", "move-word-left", "move-word-right", "delete-next", "backspace",
+	"delete-previous-word", "delete-previous-character", "clear", "tab-complete", "ignore",
+}, map[string]string{
+	"Left":       "move-left",
+	"Right":      "move-right",
+	"Ctrl+Left":  "move-word-left",
+	"Ctrl+Right": "move-word-right",
+	"Delete":     "delete-next",
+	"Backspace":  "backspace",
+	"Backspace2": "backspace",
+	"Tab":        "tab-complete",
+	"Ctrl+U":     "ignore",
+	"Ctrl+W":     "ignore",
+})
+
+// InputFieldVimKeymap is an alternative keymap for input fields with readline-style Ctrl+U and Ctrl+W bindings.
+var InputFieldVimKeymap = RegisterKeymap(InputFieldKeymap.Clone("input-field-vim").
+	MustBind("Ctrl+U", "clear").
+	MustBind("Ctrl+W", "delete-previous-word"))
+
+// SetKeymap sets the keymap used by this input field. If nil, InputFieldKeymap is used.
+func (field *InputField) SetKeymap(keymap *Keymap) *InputField {
+	field.keymap = keymap
+	field.keyChord.Reset()
+	return field
+}
+
+func (field *InputField) getKeymap() *Keymap {
+	if field.keymap != nil {
+		return field.keymap
+	}
+	return InputFieldKeymap
+}
+
 func (field *InputField) OnKeyEvent(event KeyEvent) bool {
 	defer field.handleInputChanges(field.text)
 
-	// Process key event.
-	switch key := event.Key(); key {
-	case tcell.KeyRune:
-		field.TypeRune(event.Rune())
-	case tcell.KeyLeft:
-		field.MoveCursorLeft(event.Modifiers() == tcell.ModCtrl)
-	case tcell.KeyRight:
-		field.MoveCursorRight(event.Modifiers() == tcell.ModCtrl)
-	case tcell.KeyDelete:
-		field.RemoveNextCharacter()
-	case tcell.KeyCtrlU:
-		if field.vimBindings {
-			field.Clear()
+	keymap := field.getKeymap()
+	action, consumed := keymap.Process(&field.keyChord, event)
+	for _, kp := range field.keyChord.Abandoned() {
+		if kp.Key == tcell.KeyRune {
+			field.TypeRune(kp.Rune)
 		}
-	case tcell.KeyCtrlW:
-		if field.vimBindings {
-			field.RemovePreviousWord()
+	}
+	if !consumed {
+		action = keymap.lookupWithoutModifiers(event)
+		consumed = action != ""
+	}
+	if !consumed {
+		if event.Key() != tcell.KeyRune {
+			return false
 		}
-	case tcell.KeyBackspace:
-		if Backspace1RemovesWord {
-			field.RemovePreviousWord()
-		} else {
-			field.RemovePreviousCharacter()
+		field.TypeRune(event.Rune())
+		return true
+	}
+	// Process key event.
+	switch action {
+	case "move-left", "move-word-left":
+		field.MoveCursorLeft(action == "move-word-left")
+	case "move-right", "move-word-right":
+		field.MoveCursorRight(action == "move-word-right")
+	case "delete-next":
+		field.RemoveNextCharacter()
+	case "clear":
+		field.Clear()
+	case "delete-previous-word":
+		field.RemovePreviousWord()
+	case "delete-previous-character":
+		field.RemovePreviousCharacter()
+	case "backspace":
+		removeWord := Backspace2RemovesWord
+		if event.Key() == tcell.KeyBackspace {
+			removeWord = Backspace1RemovesWord
 		}
-	case tcell.KeyBackspace2:
-		if Backspace2RemovesWord {
+		if removeWord {
 			field.RemovePreviousWord()
 		} else {
 			field.RemovePreviousCharacter()
 		}
-	case tcell.KeyTab:
+	case "tab-complete":
 		if field.tabComplete != nil {
 			field.tabComplete(field.text, field.cursorOffset)
 			return true
 		}
 		return false
-	default:
-		return false
 	}
 	return true
 }
@@ -0,0 +1,45 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestInputField_Keys(t *testing.T) {
+	field := NewInputField().SetText("hello world")
+	field.SetCursorOffset(11)
+
+	// Without the readline-style bindings, Ctrl+U and Ctrl+W are consumed without changing anything.
+	for _, key := range []tcell.Key{tcell.KeyCtrlU, tcell.KeyCtrlW} {
+		if !field.OnKeyEvent(tcell.NewEventKey(key, 0, tcell.ModCtrl)) {
+			t.Errorf("expected %s to be consumed", tcell.KeyNames[key])
+		}
+	}
+	if text := field.GetText(); text != "hello world" {
+		t.Errorf("expected Ctrl+U and Ctrl+W not to change the text by default, got %q", text)
+	}
+	field.OnKeyEvent(tcell.NewEventKey(tcell.KeyBackspace2, 0, tcell.ModAlt))
+	field.OnKeyEvent(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModAlt))
+	field.OnKeyEvent(tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModCtrl))
+	if text := field.GetText(); text != "hello wor" {
+		t.Errorf("expected modified Backspace and Delete to remove characters, got %q", text)
+	}
+
+	field.SetKeymap(InputFieldVimKeymap)
+	field.OnKeyEvent(tcell.NewEventKey(tcell.KeyCtrlW, 0, tcell.ModCtrl))
+	if text := field.GetText(); text != "hello " {
+		t.Errorf("expected Ctrl+W to remove the previous word with the readline-style bindings, got %q", text)
+	}
+	field.OnKeyEvent(tcell.NewEventKey(tcell.KeyCtrlU, 0, tcell.ModCtrl))
+	if text := field.GetText(); text != "" {
+		t.Errorf("expected Ctrl+U to clear the field with the readline-style bindings, got %q", text)
+	}
+}
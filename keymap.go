@@ -0,0 +1,647 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+var (
+	ErrUnknownKey      = errors.New("unknown key")
+	ErrUnknownModifier = errors.New("unknown modifier")
+	ErrUnknownAction   = errors.New("unknown action")
+	ErrEmptyKeys       = errors.New("empty key sequence")
+	ErrKeyConflict     = errors.New("key sequence conflicts with another binding")
+)
+
+// ChordTimeout is the maximum delay between the key presses of a multi-key binding like "g g".
+var ChordTimeout = 1 * time.Second
+
+// KeyPress is a single key press with modifiers, e.g. "Ctrl+Shift+Left" or "g".
+//
+// Key presses are normalized so that they can be compared directly: printable characters always have the
+// tcell.KeyRune key and never the shift modifier (uppercase letters are separate runes), while control
+// characters like Ctrl+A never have the ctrl modifier, as it's already included in the key code.
+//
+// Backspace, Tab, Escape and Enter are the exception: they can be typed without ctrl, so they keep the ctrl
+// modifier, and e.g. Ctrl+Enter is a different key press than Enter. Ctrl+H, Ctrl+I and Ctrl+M are the same
+// as Ctrl+Backspace, Ctrl+Tab and Ctrl+Enter. Note that most terminals send Ctrl+H, Ctrl+I and Ctrl+M as plain
+// Backspace, Tab and Enter, and can't send the ctrl variants of those keys at all.
+type KeyPress struct {
+	Key       tcell.Key
+	Rune      rune
+	Modifiers tcell.ModMask
+}
+
+// NewKeyPress creates a normalized KeyPress from a key event.
+func NewKeyPress(event KeyEvent) KeyPress {
+	return KeyPress{Key: event.Key(), Rune: event.Rune(), Modifiers: event.Modifiers()}.normalize()
+}
+
+func (kp KeyPress) normalize() KeyPress {
+	if kp.Key == tcell.KeyRune {
+		kp.Modifiers &^= tcell.ModShift
+		return kp
+	}
+	kp.Rune = 0
+	if kp.Key < ' ' && !isTypeableControlKey(kp.Key) {
+		kp.Modifiers &^= tcell.ModCtrl
+	} else if kp.Key == tcell.KeyBacktab {
+		kp.Modifiers &^= tcell.ModShift
+	}
+	return kp
+}
+
+// isTypeableControlKey returns true for the control characters that have their own key on the keyboard.
+func isTypeableControlKey(key tcell.Key) bool {
+	switch key {
+	case tcell.KeyBackspace, tcell.KeyTab, tcell.KeyEscape, tcell.KeyEnter:
+		return true
+	default:
+		return false
+	}
+}
+
+// event returns a key event equivalent to the event the key press was created from.
+func (kp KeyPress) event() *tcell.EventKey {
+	mods := kp.Modifiers
+	if kp.Key < ' ' && !isTypeableControlKey(kp.Key) {
+		mods |= tcell.ModCtrl
+	} else if kp.Key == tcell.KeyBacktab {
+		mods |= tcell.ModShift
+	}
+	return tcell.NewEventKey(kp.Key, kp.Rune, mods)
+}
+
+// lookupWithoutModifiers returns the action bound to the key of the event without any modifiers, or an empty
+// string if the event doesn't have modifiers. Built-in components use it as a fallback for modifier combinations
+// that aren't bound, as they used to handle keys regardless of modifiers (e.g. Shift+Enter like Enter).
+func (km *Keymap) lookupWithoutModifiers(event KeyEvent) string {
+	kp := NewKeyPress(event)
+	if kp.Modifiers == tcell.ModNone {
+		return ""
+	}
+	kp.Modifiers = tcell.ModNone
+	action, _ := km.Lookup([]KeyPress{kp})
+	return action
+}
+
+var modifierNames = []struct {
+	mod  tcell.ModMask
+	name string
+}{
+	{tcell.ModCtrl, "Ctrl"},
+	{tcell.ModAlt, "Alt"},
+	{tcell.ModMeta, "Meta"},
+	{tcell.ModShift, "Shift"},
+}
+
+var modifierAliases = map[string]tcell.ModMask{
+	"ctrl":    tcell.ModCtrl,
+	"control": tcell.ModCtrl,
+	"alt":     tcell.ModAlt,
+	"meta":    tcell.ModMeta,
+	"shift":   tcell.ModShift,
+}
+
+var (
+	keyNamesOnce  sync.Once
+	keyNamesLower map[string]tcell.Key
+)
+
+func lookupKeyName(name string) (tcell.Key, bool) {
+	keyNamesOnce.Do(func() {
+		keyNamesLower = map[string]tcell.Key{
+			"escape":   tcell.KeyEscape,
+			"return":   tcell.KeyEnter,
+			"pageup":   tcell.KeyPgUp,
+			"pagedown": tcell.KeyPgDn,
+			"del":      tcell.KeyDelete,
+			"ins":      tcell.KeyInsert,
+		}
+		for key, keyName := range tcell.KeyNames {
+			// Control characters are handled separately, as they're written as Ctrl+<letter>.
+			if !strings.HasPrefix(keyName, "Ctrl-") {
+				keyNamesLower[strings.ToLower(keyName)] = key
+			}
+		}
+	})
+	key, ok := keyNamesLower[strings.ToLower(name)]
+	return key, ok
+}
+
+// ParseKeyPress parses a single key press like "Ctrl+Shift+Left", "Alt+x", "Space" or "G".
+//
+// Modifiers (Ctrl, Alt, Meta and Shift) and key names (like Enter, PgDn or F5) are case-insensitive,
+// but single characters are not. Shift+<letter> is equivalent to the uppercase letter.
+func ParseKeyPress(str string) (KeyPress, error) {
+	var kp KeyPress
+	var keyName string
+	parts := strings.Split(str, "+")
+	if strings.HasSuffix(str, "+") {
+		// The key itself is a plus sign, e.g. "Ctrl++" or "+".
+		keyName = "+"
+		parts = parts[:len(parts)-2]
+	} else {
+		keyName = parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+	for _, part := range parts {
+		mod, ok := modifierAliases[strings.ToLower(strings.TrimSpace(part))]
+		if !ok {
+			return kp, fmt.Errorf("%w %q in %q", ErrUnknownModifier, part, str)
+		}
+		kp.Modifiers |= mod
+	}
+	if utf8.RuneCountInString(keyName) == 1 {
+		kp.Key = tcell.KeyRune
+		kp.Rune, _ = utf8.DecodeRuneInString(keyName)
+		lower := unicode.ToLower(kp.Rune)
+		if kp.Modifiers&tcell.ModCtrl != 0 && lower >= 'a' && lower <= 'z' {
+			kp.Key = tcell.KeyCtrlA + tcell.Key(lower-'a')
+		} else if kp.Modifiers&tcell.ModShift != 0 {
+			kp.Rune = unicode.ToUpper(kp.Rune)
+		}
+	} else if strings.EqualFold(keyName, "space") {
+		kp.Key = tcell.KeyRune
+		kp.Rune = ' '
+		if kp.Modifiers&tcell.ModCtrl != 0 {
+			kp.Key = tcell.KeyCtrlSpace
+		}
+	} else if key, ok := lookupKeyName(keyName); ok {
+		kp.Key = key
+		if key == tcell.KeyTab && kp.Modifiers&tcell.ModShift != 0 {
+			kp.Key = tcell.KeyBacktab
+		}
+	} else {
+		return kp, fmt.Errorf("%w %q in %q", ErrUnknownKey, keyName, str)
+	}
+	return kp.normalize(), nil
+}
+
+// ParseKeySequence parses a space-separated sequence of key presses like "g g" or "Ctrl+X Ctrl+S".
+func ParseKeySequence(str string) ([]KeyPress, error) {
+	parts := strings.Fields(str)
+	if len(parts) == 0 {
+		return nil, ErrEmptyKeys
+	}
+	seq := make([]KeyPress, len(parts))
+	for i, part := range parts {
+		var err error
+		seq[i], err = ParseKeyPress(part)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return seq, nil
+}
+
+// String returns the key press in the format accepted by ParseKeyPress.
+func (kp KeyPress) String() string {
+	var buf strings.Builder
+	mods := kp.Modifiers
+	var key string
+	switch {
+	case kp.Key == tcell.KeyRune && kp.Rune == ' ':
+		key = "Space"
+	case kp.Key == tcell.KeyRune:
+		key = string(kp.Rune)
+	case kp.Key == tcell.KeyCtrlSpace:
+		key = "Space"
+		mods |= tcell.ModCtrl
+	case kp.Key >= tcell.KeyCtrlA && kp.Key <= tcell.KeyCtrlZ &&
+		kp.Key != tcell.KeyBackspace && kp.Key != tcell.KeyTab && kp.Key != tcell.KeyEnter:
+		key = string(rune('A' + kp.Key - tcell.KeyCtrlA))
+		mods |= tcell.ModCtrl
+	default:
+		var ok bool
+		key, ok = tcell.KeyNames[kp.Key]
+		if !ok {
+			key = fmt.Sprintf("Key[%d]", kp.Key)
+		}
+	}
+	for _, mod := range modifierNames {
+		if mods&mod.mod != 0 {
+			buf.WriteString(mod.name)
+			buf.WriteByte('+')
+		}
+	}
+	buf.WriteString(key)
+	return buf.String()
+}
+
+// FormatKeySequence formats a sequence of key presses in the format accepted by ParseKeySequence.
+func FormatKeySequence(seq []KeyPress) string {
+	parts := make([]string, len(seq))
+	for i, kp := range seq {
+		parts[i] = kp.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+type keymapNode struct {
+	action   string
+	children map[KeyPress]*keymapNode
+}
+
+func (node *keymapNode) clone() *keymapNode {
+	clone := &keymapNode{action: node.action}
+	if node.children != nil {
+		clone.children = make(map[KeyPress]*keymapNode, len(node.children))
+		for kp, child := range node.children {
+			clone.children[kp] = child.clone()
+		}
+	}
+	return clone
+}
+
+func (node *keymapNode) collect(prefix []KeyPress, into map[string]string) {
+	if node.action != "" {
+		into[FormatKeySequence(prefix)] = node.action
+	}
+	for kp, child := range node.children {
+		child.collect(append(slices.Clone(prefix), kp), into)
+	}
+}
+
+// Keymap maps key sequences to named actions.
+//
+// Each keymap has a fixed set of actions that keys can be bound to, which makes it possible to validate
+// bindings loaded from config files. Multi-key sequences (chords) are supported, but a key sequence can't be
+// bound if it's a prefix of another binding or vice versa, as there would be no way to tell them apart.
+type Keymap struct {
+	lock    sync.RWMutex
+	name    string
+	actions []string
+	root    *keymapNode
+}
+
+// NewKeymap creates a new empty keymap with the given name and set of valid actions.
+func NewKeymap(name string, actions ...string) *Keymap {
+	return &Keymap{
+		name:    name,
+		actions: slices.Clone(actions),
+		root:    &keymapNode{},
+	}
+}
+
+// Name returns the name of the keymap, which is used as the scope name in the keymap registry.
+func (km *Keymap) Name() string {
+	return km.name
+}
+
+// Actions returns the actions that keys can be bound to in this keymap.
+func (km *Keymap) Actions() []string {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return slices.Clone(km.actions)
+}
+
+// HasAction returns true if the given action is valid in this keymap.
+func (km *Keymap) HasAction(action string) bool {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return slices.Contains(km.actions, action)
+}
+
+// AddActions adds actions to the set of valid actions in this keymap.
+func (km *Keymap) AddActions(actions ...string) *Keymap {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	for _, action := range actions {
+		if !slices.Contains(km.actions, action) {
+			km.actions = append(km.actions, action)
+		}
+	}
+	return km
+}
+
+// RemoveActions removes actions from the set of valid actions in this keymap, along with all their bindings.
+func (km *Keymap) RemoveActions(actions ...string) *Keymap {
+	for _, action := range actions {
+		km.UnbindAction(action)
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	km.actions = slices.DeleteFunc(km.actions, func(action string) bool {
+		return slices.Contains(actions, action)
+	})
+	return km
+}
+
+// Bind binds the given key sequence (in the format accepted by ParseKeySequence) to an action.
+// Existing bindings of the exact same sequence are replaced.
+func (km *Keymap) Bind(keys, action string) error {
+	seq, err := ParseKeySequence(keys)
+	if err != nil {
+		return err
+	}
+	return km.BindSequence(seq, action)
+}
+
+// MustBind calls Bind and panics if it fails. It's meant for defining default keymaps.
+func (km *Keymap) MustBind(keys, action string) *Keymap {
+	if err := km.Bind(keys, action); err != nil {
+		panic(fmt.Errorf("failed to bind %q in keymap %s: %w", keys, km.name, err))
+	}
+	return km
+}
+
+// BindSequence binds the given parsed key sequence to an action.
+func (km *Keymap) BindSequence(seq []KeyPress, action string) error {
+	if len(seq) == 0 {
+		return ErrEmptyKeys
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if !slices.Contains(km.actions, action) {
+		return fmt.Errorf("%w %q", ErrUnknownAction, action)
+	}
+	node := km.root
+	for i, kp := range seq {
+		if node.action != "" {
+			return fmt.Errorf("%w: %q is bound to %s", ErrKeyConflict, FormatKeySequence(seq[:i]), node.action)
+		}
+		next, ok := node.children[kp]
+		if !ok {
+			next = &keymapNode{}
+			if node.children == nil {
+				node.children = make(map[KeyPress]*keymapNode)
+			}
+			node.children[kp] = next
+		}
+		node = next
+	}
+	if len(node.children) > 0 {
+		return fmt.Errorf("%w: %q is a prefix of other bindings", ErrKeyConflict, FormatKeySequence(seq))
+	}
+	node.action = action
+	return nil
+}
+
+// Unbind removes the binding of the given key sequence. Returns false if the sequence wasn't bound.
+func (km *Keymap) Unbind(keys string) (bool, error) {
+	seq, err := ParseKeySequence(keys)
+	if err != nil {
+		return false, err
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	return km.unbind(km.root, seq), nil
+}
+
+func (km *Keymap) unbind(node *keymapNode, seq []KeyPress) bool {
+	if len(seq) == 0 {
+		found := node.action != ""
+		node.action = ""
+		return found
+	}
+	child, ok := node.children[seq[0]]
+	if !ok {
+		return false
+	}
+	found := km.unbind(child, seq[1:])
+	if child.action == "" && len(child.children) == 0 {
+		delete(node.children, seq[0])
+	}
+	return found
+}
+
+// UnbindAction removes all bindings of the given action.
+func (km *Keymap) UnbindAction(action string) {
+	for keys, boundAction := range km.Bindings() {
+		if boundAction == action {
+			_, _ = km.Unbind(keys)
+		}
+	}
+}
+
+// Clear removes all bindings from the keymap. The set of valid actions is not changed.
+func (km *Keymap) Clear() {
+	km.lock.Lock()
+	km.root = &keymapNode{}
+	km.lock.Unlock()
+}
+
+// Bindings returns all bindings in the keymap as a map from key sequences to action names.
+func (km *Keymap) Bindings() map[string]string {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	bindings := make(map[string]string)
+	km.root.collect(nil, bindings)
+	return bindings
+}
+
+// KeysFor returns the key sequences bound to the given action in sorted order.
+func (km *Keymap) KeysFor(action string) []string {
+	var keys []string
+	for seq, boundAction := range km.Bindings() {
+		if boundAction == action {
+			keys = append(keys, seq)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Clone returns a copy of the keymap with the given name.
+func (km *Keymap) Clone(name string) *Keymap {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return &Keymap{
+		name:    name,
+		actions: slices.Clone(km.actions),
+		root:    km.root.clone(),
+	}
+}
+
+// Lookup finds the action bound to the given key sequence.
+// If the sequence is a prefix of one or more bindings, the action is empty and isPrefix is true.
+func (km *Keymap) Lookup(seq []KeyPress) (action string, isPrefix bool) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	node := km.root
+	for _, kp := range seq {
+		node = node.children[kp]
+		if node == nil {
+			return "", false
+		}
+	}
+	return node.action, len(node.children) > 0
+}
+
+// ChordState keeps track of a partially entered multi-key binding. Components that use keymaps should keep one
+// ChordState per keymap user and pass it to Keymap.Process. The zero value is ready to use.
+type ChordState struct {
+	pending   []KeyPress
+	abandoned []KeyPress
+	lastPress time.Time
+}
+
+// Pending returns the key presses of the partially entered binding, if any.
+func (cs *ChordState) Pending() []KeyPress {
+	if cs.expired() {
+		return nil
+	}
+	return cs.pending
+}
+
+// Abandoned returns the key presses of a multi-key binding that was abandoned during the last call to
+// Keymap.Process. Components should handle them like unbound keys (e.g. type them) before the current event,
+// so that keys aren't silently dropped.
+func (cs *ChordState) Abandoned() []KeyPress {
+	return cs.abandoned
+}
+
+// Reset discards the partially entered binding.
+func (cs *ChordState) Reset() {
+	cs.pending = nil
+}
+
+func (cs *ChordState) expired() bool {
+	return len(cs.pending) > 0 && time.Since(cs.lastPress) > ChordTimeout
+}
+
+// Process feeds a key event into the keymap.
+//
+// If the event completes a binding, the bound action is returned. If it starts or continues a multi-key binding,
+// the action is empty but consumed is true. Otherwise, consumed is false and the event should be handled normally.
+// When a multi-key binding is abandoned (by pressing an unbound key or waiting longer than ChordTimeout),
+// the keys entered so far are returned by ChordState.Abandoned until the next call.
+func (km *Keymap) Process(state *ChordState, event KeyEvent) (action string, consumed bool) {
+	state.abandoned = nil
+	if state.expired() {
+		state.abandoned = state.pending
+		state.Reset()
+	}
+	kp := NewKeyPress(event)
+	seq := append(state.pending, kp)
+	action, isPrefix := km.Lookup(seq)
+	if action == "" && !isPrefix && len(state.pending) > 0 {
+		state.abandoned = state.pending
+		state.Reset()
+		seq = []KeyPress{kp}
+		action, isPrefix = km.Lookup(seq)
+	}
+	if isPrefix {
+		state.pending = seq
+		state.lastPress = time.Now()
+		return "", true
+	}
+	state.Reset()
+	return action, action != ""
+}
+
+var keymapRegistry = struct {
+	sync.RWMutex
+	keymaps map[string]*Keymap
+}{keymaps: make(map[string]*Keymap)}
+
+// RegisterKeymap adds a keymap to the global registry, replacing any existing keymap with the same name.
+// The default keymaps of built-in components are registered automatically.
+func RegisterKeymap(km *Keymap) *Keymap {
+	keymapRegistry.Lock()
+	keymapRegistry.keymaps[km.name] = km
+	keymapRegistry.Unlock()
+	return km
+}
+
+// GetKeymap returns the registered keymap with the given name, or nil if there's no such keymap.
+func GetKeymap(name string) *Keymap {
+	keymapRegistry.RLock()
+	defer keymapRegistry.RUnlock()
+	return keymapRegistry.keymaps[name]
+}
+
+// RegisteredKeymaps returns the names of all registered keymaps in sorted order.
+func RegisteredKeymaps() []string {
+	keymapRegistry.RLock()
+	defer keymapRegistry.RUnlock()
+	names := make([]string, 0, len(keymapRegistry.keymaps))
+	for name := range keymapRegistry.keymaps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newDefaultKeymap(name string, actions []string, bindings map[string]string) *Keymap {
+	km := NewKeymap(name, actions...)
+	for keys, action := range bindings {
+		km.MustBind(keys, action)
+	}
+	return RegisterKeymap(km)
+}
+
+// AppKeymapName is the name of application-wide keymaps.
+const AppKeymapName = "app"
+
+// Keymap returns the application-wide keymap. Key events are matched against it before being passed to any
+// components, except while a modal layer is open. Actions must be registered with SetKeyAction before keys can
+// be bound to them.
+func (app *Application) Keymap() *Keymap {
+	return app.keymap
+}
+
+// SetKeyAction registers an application-wide action that can be bound in the keymap returned by Keymap.
+// The handler is called on the main loop and should return true if the screen should be redrawn.
+// A nil handler removes the action and all its bindings.
+func (app *Application) SetKeyAction(name string, handler func() bool) *Application {
+	app.keyActionsLock.Lock()
+	defer app.keyActionsLock.Unlock()
+	if handler == nil {
+		delete(app.keyActions, name)
+		app.keymap.RemoveActions(name)
+	} else {
+		app.keyActions[name] = handler
+		app.keymap.AddActions(name)
+	}
+	return app
+}
+
+// handleKeyBinding matches a key event against the application-wide keymap. If a multi-key binding is abandoned,
+// the keys entered so far are passed to the components before returning, as if there was no binding.
+func (app *Application) handleKeyBinding(event KeyEvent) (consumed, redraw bool) {
+	if app.hasModalLayer() {
+		// Modal layers capture all input, including keys that would otherwise trigger global actions.
+		app.keyChord.Reset()
+		return false, false
+	}
+	action, consumed := app.keymap.Process(&app.keyChord, event)
+	for _, kp := range app.keyChord.Abandoned() {
+		redraw = app.dispatchKeyEvent(kp.event()) || redraw
+	}
+	if action == "" {
+		return consumed, redraw
+	}
+	app.keyActionsLock.RLock()
+	handler, ok := app.keyActions[action]
+	app.keyActionsLock.RUnlock()
+	if !ok {
+		return false, redraw
+	}
+	return true, handler() || redraw
+}
+
+// dispatchKeyEvent passes a key event to the layers and the root component, and if none of them handle it,
+// to the focus manager.
+func (app *Application) dispatchKeyEvent(event KeyEvent) bool {
+	return app.dispatchEvent(func(comp Component) bool {
+		return comp.OnKeyEvent(event)
+	}) || app.focusManager.onKeyEvent(event)
+}
@@ -0,0 +1,128 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestKeymap_Process(t *testing.T) {
+	keymap := NewKeymap("test", "save", "quit", "up").
+		MustBind("Ctrl+X Ctrl+S", "save").
+		MustBind("Ctrl+X Ctrl+C", "quit").
+		MustBind("Up", "up")
+	var state ChordState
+	press := func(key tcell.Key, r rune, mod tcell.ModMask) (string, bool) {
+		return keymap.Process(&state, tcell.NewEventKey(key, r, mod))
+	}
+
+	if action, consumed := press(tcell.KeyUp, 0, tcell.ModNone); action != "up" || !consumed {
+		t.Errorf("expected single key binding to match, got %q %t", action, consumed)
+	}
+	if action, consumed := press(tcell.KeyCtrlX, 0, tcell.ModCtrl); action != "" || !consumed {
+		t.Errorf("expected chord prefix to be consumed, got %q %t", action, consumed)
+	}
+	if action, consumed := press(tcell.KeyCtrlS, 0, tcell.ModCtrl); action != "save" || !consumed {
+		t.Errorf("expected chord to complete, got %q %t", action, consumed)
+	}
+	if len(state.Abandoned()) != 0 {
+		t.Errorf("expected nothing to be abandoned after a completed chord")
+	}
+
+	// Pressing another bound key abandons the chord, but the new key still works.
+	press(tcell.KeyCtrlX, 0, tcell.ModCtrl)
+	if action, consumed := press(tcell.KeyUp, 0, tcell.ModNone); action != "up" || !consumed {
+		t.Errorf("expected key after abandoned chord to match, got %q %t", action, consumed)
+	}
+	if abandoned := state.Abandoned(); !slices.Equal(abandoned, []KeyPress{{Key: tcell.KeyCtrlX}}) {
+		t.Errorf("expected the chord prefix to be reported as abandoned, got %v", abandoned)
+	}
+	press(tcell.KeyUp, 0, tcell.ModNone)
+	if len(state.Abandoned()) != 0 {
+		t.Errorf("expected abandoned keys to be cleared by the next call")
+	}
+
+	// Chords also expire after ChordTimeout.
+	press(tcell.KeyCtrlX, 0, tcell.ModCtrl)
+	state.lastPress = time.Now().Add(-ChordTimeout - time.Second)
+	if action, consumed := press(tcell.KeyCtrlS, 0, tcell.ModCtrl); action != "" || consumed {
+		t.Errorf("expected expired chord not to complete, got %q %t", action, consumed)
+	}
+	if len(state.Abandoned()) != 1 {
+		t.Errorf("expected the expired chord prefix to be reported as abandoned")
+	}
+}
+
+func TestParseKeyPress_CtrlTypeableKeys(t *testing.T) {
+	for _, test := range []struct {
+		str       string
+		expected  KeyPress
+		formatted string
+	}{
+		{"Enter", KeyPress{Key: tcell.KeyEnter}, "Enter"},
+		{"Ctrl+Enter", KeyPress{Key: tcell.KeyEnter, Modifiers: tcell.ModCtrl}, "Ctrl+Enter"},
+		{"Ctrl+M", KeyPress{Key: tcell.KeyEnter, Modifiers: tcell.ModCtrl}, "Ctrl+Enter"},
+		{"Ctrl+Tab", KeyPress{Key: tcell.KeyTab, Modifiers: tcell.ModCtrl}, "Ctrl+Tab"},
+		{"Ctrl+Backspace", KeyPress{Key: tcell.KeyBackspace, Modifiers: tcell.ModCtrl}, "Ctrl+Backspace"},
+		{"Ctrl+Escape", KeyPress{Key: tcell.KeyEscape, Modifiers: tcell.ModCtrl}, "Ctrl+Esc"},
+		{"Ctrl+A", KeyPress{Key: tcell.KeyCtrlA}, "Ctrl+A"},
+	} {
+		kp, err := ParseKeyPress(test.str)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", test.str, err)
+		} else if kp != test.expected || kp.String() != test.formatted {
+			t.Errorf("expected %q to parse to %+v (%s), got %+v (%s)", test.str, test.expected, test.formatted, kp, kp)
+		}
+	}
+	if kp := NewKeyPress(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModCtrl)); kp.String() != "Ctrl+Enter" {
+		t.Errorf("expected Ctrl+Enter event to keep the ctrl modifier, got %s", kp)
+	}
+	if kp := NewKeyPress(tcell.NewEventKey(tcell.KeyCtrlA, 0, tcell.ModCtrl)); kp != (KeyPress{Key: tcell.KeyCtrlA}) {
+		t.Errorf("expected Ctrl+A event to be normalized without the ctrl modifier, got %+v", kp)
+	}
+
+	keymap := NewKeymap("test-ctrl-enter", "a", "b")
+	if err := ParseKeymapConfig([]byte(`{"test-ctrl-enter": {"Enter": "a", "Ctrl+Enter": "b"}}`), keymap); err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	if bindings := keymap.Bindings(); len(bindings) != 2 || bindings["Enter"] != "a" || bindings["Ctrl+Enter"] != "b" {
+		t.Errorf("expected Enter and Ctrl+Enter to be bound separately, got %v", bindings)
+	}
+}
+
+func TestApplication_AbandonedChordReachesComponents(t *testing.T) {
+	area := NewInputArea()
+	app := NewApplication()
+	app.SetRoot(area)
+	quits := 0
+	app.SetKeyAction("quit", func() bool {
+		quits++
+		return false
+	})
+	app.Keymap().MustBind("g q", "quit")
+	sim, err := StartSimulation(app, 20, 3)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	defer sim.Stop()
+
+	sim.InjectString("gx")
+	waitForDraw(t, sim)
+	if text := area.GetText(); text != "gx" {
+		t.Errorf("expected the keys of the abandoned chord to be typed, got %q", text)
+	}
+	sim.InjectString("gq")
+	waitForDraw(t, sim)
+	if text := area.GetText(); text != "gx" || quits != 1 {
+		t.Errorf("expected the chord to trigger the action without typing, got %q and %d calls", text, quits)
+	}
+}
@@ -0,0 +1,167 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+var ErrUnknownKeymap = errors.New("unknown keymap")
+
+// KeymapConfigError describes a single problem in a keymap config.
+type KeymapConfigError struct {
+	// The name of the keymap the problem is in.
+	Keymap string
+	// The key sequence the problem is in, or an empty string if the problem isn't with a specific binding.
+	Keys string
+	Err  error
+}
+
+func (kce *KeymapConfigError) Error() string {
+	if kce.Keys == "" {
+		return fmt.Sprintf("keymap %s: %v", kce.Keymap, kce.Err)
+	}
+	return fmt.Sprintf("keymap %s: %q: %v", kce.Keymap, kce.Keys, kce.Err)
+}
+
+func (kce *KeymapConfigError) Unwrap() error {
+	return kce.Err
+}
+
+// LoadKeymapConfig reads a keymap config file and applies it, see ParseKeymapConfig for the format.
+func LoadKeymapConfig(path string, extraKeymaps ...*Keymap) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	err = ParseKeymapConfig(data, extraKeymaps...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// ParseKeymapConfig parses a JSON keymap config and applies it to the registered keymaps (see RegisterKeymap).
+//
+// The config is an object mapping keymap names to objects that map key sequences to action names:
+//
+//	{
+//	  "input-area": {"Ctrl+W": "delete-previous-word", "Ctrl+Z": ""},
+//	  "text-view": {"g g": "scroll-top", "g": ""}
+//	}
+//
+// Bindings in the config are added on top of the existing bindings, and an empty action removes the binding.
+// Removals are applied first, so a key can be unbound and used as a prefix of a multi-key binding in the same config.
+//
+// The names of the registered keymaps, including the default keymaps of all built-in components, are returned by
+// RegisteredKeymaps, and the actions of each keymap by Keymap.Actions. Keymaps that aren't registered globally,
+// like the application-wide keymap returned by Application.Keymap, can be passed as extra keymaps.
+// Extra keymaps take precedence over registered keymaps with the same name.
+//
+// The config is validated fully before anything is applied. If there are any unknown keymaps, keys or actions,
+// nothing is changed and the returned error contains a KeymapConfigError for each problem.
+func ParseKeymapConfig(data []byte, extraKeymaps ...*Keymap) error {
+	var config map[string]map[string]string
+	if err := json.Unmarshal(data, &config); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			line, column := offsetToPosition(data, syntaxErr.Offset)
+			return fmt.Errorf("invalid JSON at line %d, column %d: %w", line, column, err)
+		}
+		return fmt.Errorf("invalid keymap config: %w", err)
+	}
+	extra := make(map[string]*Keymap, len(extraKeymaps))
+	for _, km := range extraKeymaps {
+		extra[km.Name()] = km
+	}
+
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	targets := make([]*Keymap, 0, len(names))
+	updated := make([]*Keymap, 0, len(names))
+	for _, name := range names {
+		target, ok := extra[name]
+		if !ok {
+			target = GetKeymap(name)
+		}
+		if target == nil {
+			err := fmt.Errorf("%w (registered keymaps: %s)", ErrUnknownKeymap, strings.Join(RegisteredKeymaps(), ", "))
+			errs = append(errs, &KeymapConfigError{Keymap: name, Err: err})
+			continue
+		}
+		clone, bindErrs := applyKeymapConfig(target.Clone(name), config[name])
+		errs = append(errs, bindErrs...)
+		targets = append(targets, target)
+		updated = append(updated, clone)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for i, target := range targets {
+		target.replaceBindings(updated[i])
+	}
+	return nil
+}
+
+func applyKeymapConfig(km *Keymap, bindings map[string]string) (*Keymap, []error) {
+	keys := make([]string, 0, len(bindings))
+	for seq := range bindings {
+		keys = append(keys, seq)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, seq := range keys {
+		if bindings[seq] == "" {
+			if _, err := km.Unbind(seq); err != nil {
+				errs = append(errs, &KeymapConfigError{Keymap: km.Name(), Keys: seq, Err: err})
+			}
+		}
+	}
+	for _, seq := range keys {
+		if action := bindings[seq]; action != "" {
+			if err := km.Bind(seq, action); err != nil {
+				if errors.Is(err, ErrUnknownAction) {
+					err = fmt.Errorf("%w (valid actions: %s)", err, strings.Join(km.Actions(), ", "))
+				}
+				errs = append(errs, &KeymapConfigError{Keymap: km.Name(), Keys: seq, Err: err})
+			}
+		}
+	}
+	return km, errs
+}
+
+func (km *Keymap) replaceBindings(other *Keymap) {
+	other.lock.RLock()
+	root := other.root.clone()
+	other.lock.RUnlock()
+	km.lock.Lock()
+	km.root = root
+	km.lock.Unlock()
+}
+
+func offsetToPosition(data []byte, offset int64) (line, column int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line = bytes.Count(before, []byte{'\n'}) + 1
+	column = len(before) - bytes.LastIndexByte(before, '\n')
+	return
+}
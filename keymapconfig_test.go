@@ -0,0 +1,55 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRegisteredKeymaps_BuiltIn(t *testing.T) {
+	names := RegisteredKeymaps()
+	for _, name := range []string{"input-area", "input-field", "text-view", "form", "list", "table", "tree-view",
+		"checkbox", "radio-group", "drop-down", "modal", "tabs", "menu", "menu-bar", "focus"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("expected built-in keymap %q to be registered, got %v", name, names)
+		}
+	}
+	if !slices.IsSorted(names) {
+		t.Errorf("expected keymap names to be sorted, got %v", names)
+	}
+}
+
+func TestParseKeymapConfig(t *testing.T) {
+	keymap := NewKeymap("test-config", "save", "quit").MustBind("Ctrl+S", "save")
+	err := ParseKeymapConfig([]byte(`{"test-config": {"Ctrl+S": "", "Ctrl+X Ctrl+S": "save", "Ctrl+Q": "quit"}}`), keymap)
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	expected := map[string]string{"Ctrl+X Ctrl+S": "save", "Ctrl+Q": "quit"}
+	if bindings := keymap.Bindings(); len(bindings) != len(expected) ||
+		bindings["Ctrl+X Ctrl+S"] != "save" || bindings["Ctrl+Q"] != "quit" {
+		t.Errorf("expected bindings %v, got %v", expected, bindings)
+	}
+}
+
+func TestParseKeymapConfig_Errors(t *testing.T) {
+	keymap := NewKeymap("test-config", "save").MustBind("Ctrl+S", "save")
+	err := ParseKeymapConfig([]byte(`{"no-such-keymap": {}, "test-config": {"Ctrl+Q": "quit", "Ctrl+W": "save"}}`), keymap)
+	if !errors.Is(err, ErrUnknownKeymap) || !errors.Is(err, ErrUnknownAction) {
+		t.Fatalf("expected unknown keymap and action errors, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "registered keymaps: ") || !strings.Contains(err.Error(), "input-area") {
+		t.Errorf("expected unknown keymap error to list the registered keymaps, got %v", err)
+	}
+	if bindings := keymap.Bindings(); len(bindings) != 1 {
+		t.Errorf("expected keymap not to change when the config is invalid, got %v", bindings)
+	}
+}
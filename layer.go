@@ -0,0 +1,213 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// DimStyle is used to dim the layers underneath a layer that has dimming enabled.
+var DimStyle = func(style tcell.Style) tcell.Style {
+	return style.Dim(true)
+}
+
+// Layer is a component drawn above the root component of an Application, e.g. a modal, a popup or a toast.
+//
+// The component of a layer is drawn on the whole screen, so it should usually be wrapped in something like
+// a Centerer to position it. Layers are drawn in order of their z-index, and layers with the same z-index are
+// drawn in the order they were added.
+type Layer struct {
+	component Component
+	screen    *ProxyScreen
+	zIndex    int
+	modal     bool
+	dim       bool
+}
+
+// NewLayer creates a new non-modal layer with the given component.
+func NewLayer(component Component) *Layer {
+	return &Layer{
+		component: component,
+		screen:    &ProxyScreen{Style: tcell.StyleDefault},
+	}
+}
+
+// SetZIndex sets the z-index of the layer. Layers with a higher z-index are drawn on top of lower ones.
+func (layer *Layer) SetZIndex(zIndex int) *Layer {
+	layer.zIndex = zIndex
+	return layer
+}
+
+// SetModal sets whether the layer captures input.
+//
+// Events are passed to layers from the top down, and then to the root component. Non-modal layers only stop
+// the propagation if they handle the event, while modal layers never pass events to the layers underneath.
+// The application-wide keymap (see Application.Keymap) is also disabled while a modal layer is open.
+func (layer *Layer) SetModal(modal bool) *Layer {
+	layer.modal = modal
+	return layer
+}
+
+// SetDimBackground sets whether everything underneath the layer should be dimmed using DimStyle.
+func (layer *Layer) SetDimBackground(dim bool) *Layer {
+	layer.dim = dim
+	return layer
+}
+
+func (layer *Layer) GetComponent() Component {
+	return layer.component
+}
+
+func (layer *Layer) GetZIndex() int {
+	return layer.zIndex
+}
+
+func (layer *Layer) IsModal() bool {
+	return layer.modal
+}
+
+// layerUpdate is a change to the layers of a running application, which is applied by the main loop.
+type layerUpdate struct {
+	layer  *Layer
+	remove bool
+}
+
+// AddLayer adds a layer above the root component. If the layer's component is focusable, it will be focused.
+// The focus state of the root component and other layers is not changed.
+//
+// AddLayer and RemoveLayer can be called from any goroutine, including event handlers and other code running on
+// the main loop. They never block: while the application is running, the change is applied before the next draw.
+func (app *Application) AddLayer(layer *Layer) {
+	app.queueLayerUpdate(layerUpdate{layer: layer})
+}
+
+// RemoveLayer removes a layer that was previously added with AddLayer.
+// If the layer's component is focusable, it will be blurred.
+func (app *Application) RemoveLayer(layer *Layer) {
+	app.queueLayerUpdate(layerUpdate{layer: layer, remove: true})
+}
+
+func (app *Application) queueLayerUpdate(update layerUpdate) {
+	app.screenLock.RLock()
+	defer app.screenLock.RUnlock()
+	if app.screen == nil {
+		app.applyLayerUpdate(update)
+		return
+	}
+	app.layerUpdatesLock.Lock()
+	app.layerUpdates = append(app.layerUpdates, update)
+	app.layerUpdatesLock.Unlock()
+	// The main loop applies pending layer updates after handling anything, so if the update queue is full,
+	// the loop is going to wake up anyway. Blocking here would deadlock if this is called from the main loop.
+	select {
+	case app.updates <- redrawUpdate{}:
+	default:
+	}
+}
+
+// applyLayerUpdates applies the pending layer updates. The first return value is true if there were any updates,
+// and the second one is true if any of them removed a layer.
+func (app *Application) applyLayerUpdates() (updated, removed bool) {
+	app.layerUpdatesLock.Lock()
+	updates := app.layerUpdates
+	app.layerUpdates = nil
+	app.layerUpdatesLock.Unlock()
+	for _, update := range updates {
+		app.applyLayerUpdate(update)
+		removed = removed || update.remove
+	}
+	return len(updates) > 0, removed
+}
+
+func (app *Application) applyLayerUpdate(update layerUpdate) {
+	if update.remove {
+		app.removeLayer(update.layer)
+	} else {
+		app.addLayer(update.layer)
+	}
+}
+
+func (app *Application) addLayer(layer *Layer) {
+	if slices.Contains(app.layers, layer) {
+		return
+	}
+	app.layers = append(app.layers, layer)
+	focusable, ok := layer.component.(Focusable)
+	if ok {
+		focusable.Focus()
+	}
+}
+
+func (app *Application) removeLayer(layer *Layer) {
+	index := slices.Index(app.layers, layer)
+	if index < 0 {
+		return
+	}
+	app.layers = slices.Delete(app.layers, index, index+1)
+	focusable, ok := layer.component.(Focusable)
+	if ok {
+		focusable.Blur()
+	}
+}
+
+// sortLayers sorts the layers from bottom to top. The z-index can be changed after adding a layer,
+// so this is done every time the layers are used.
+func (app *Application) sortLayers() []*Layer {
+	slices.SortStableFunc(app.layers, func(a, b *Layer) int {
+		return a.zIndex - b.zIndex
+	})
+	return app.layers
+}
+
+func (app *Application) hasModalLayer() bool {
+	return slices.ContainsFunc(app.layers, (*Layer).IsModal)
+}
+
+// dispatchEvent passes an event to the layers from the top down and then to the root component.
+// Returns true if the screen should be redrawn.
+func (app *Application) dispatchEvent(handle func(Component) bool) bool {
+	layers := app.sortLayers()
+	for i := len(layers) - 1; i >= 0; i-- {
+		if handle(layers[i].component) {
+			return true
+		} else if layers[i].modal {
+			return false
+		}
+	}
+	return handle(app.root)
+}
+
+func (app *Application) drawLayers(screen Screen) {
+	width, height := screen.Size()
+	for _, layer := range app.sortLayers() {
+		if layer.dim {
+			dimScreen(screen, width, height)
+		}
+		if layer.modal {
+			// Components below a modal layer can't receive input, so they shouldn't show the cursor either.
+			screen.HideCursor()
+		}
+		layer.screen.Parent = screen
+		layer.screen.Width, layer.screen.Height = width, height
+		drawChild(layer.component, layer.screen, false, tcell.StyleDefault)
+	}
+}
+
+func dimScreen(screen Screen, width, height int) {
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mainc, combc, style, cellWidth := screen.GetContent(x, y)
+			screen.SetContent(x, y, mainc, combc, DimStyle(style))
+			if cellWidth > 1 {
+				x += cellWidth - 1
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestLayer_ModalCapturesInput(t *testing.T) {
+	root, popup, modal := NewInputField(), NewInputField(), NewInputField()
+	app := NewApplication()
+	app.SetRoot(root)
+	globalActions := 0
+	app.SetKeyAction("count", func() bool {
+		globalActions++
+		return false
+	})
+	app.Keymap().MustBind("Ctrl+K", "count")
+	sim, err := StartSimulation(app, 20, 3)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	defer sim.Stop()
+
+	sim.InjectKey(tcell.KeyCtrlK, 0, tcell.ModCtrl).InjectString("a")
+	waitForDraw(t, sim)
+	if globalActions != 1 || root.GetText() != "a" {
+		t.Fatalf("expected global action and root input without layers, got %d and %q", globalActions, root.GetText())
+	}
+
+	// Non-modal layers get events first, but pass on the ones they don't handle.
+	app.AddLayer(NewLayer(popup))
+	sim.InjectString("b").InjectKey(tcell.KeyCtrlK, 0, tcell.ModCtrl)
+	waitForDraw(t, sim)
+	if globalActions != 2 || popup.GetText() != "b" || root.GetText() != "a" {
+		t.Fatalf("unexpected state with non-modal layer: %d, %q, %q", globalActions, popup.GetText(), root.GetText())
+	}
+
+	modalLayer := NewLayer(modal).SetModal(true)
+	app.AddLayer(modalLayer)
+	sim.InjectKey(tcell.KeyCtrlK, 0, tcell.ModCtrl).InjectString("c").InjectKey(tcell.KeyLeft, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if globalActions != 2 {
+		t.Errorf("expected global keymap to be disabled while a modal layer is open")
+	}
+	if modal.GetText() != "c" || popup.GetText() != "b" || root.GetText() != "a" {
+		t.Errorf("expected input to only go to the modal layer, got %q, %q, %q",
+			modal.GetText(), popup.GetText(), root.GetText())
+	}
+
+	app.RemoveLayer(modalLayer)
+	sim.InjectKey(tcell.KeyCtrlK, 0, tcell.ModCtrl)
+	waitForDraw(t, sim)
+	if globalActions != 3 {
+		t.Errorf("expected global keymap to work again after the modal layer was removed")
+	}
+}
+
+func TestLayer_AddFromMainLoopWithFullQueue(t *testing.T) {
+	popup := NewInputField()
+	layer := NewLayer(popup)
+	app := NewApplication()
+	app.SetRoot(NewInputField())
+	app.SetKeyAction("popup", func() bool {
+		// Fill the update queue, so that a blocking send would never return.
+		for len(app.updates) < cap(app.updates) {
+			app.updates <- redrawUpdate{}
+		}
+		app.AddLayer(layer)
+		return true
+	})
+	app.Keymap().MustBind("Ctrl+P", "popup")
+	sim, err := StartSimulation(app, 20, 3)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	defer sim.Stop()
+	sim.Timeout = time.Second
+
+	sim.InjectKey(tcell.KeyCtrlP, 0, tcell.ModCtrl).InjectString("a")
+	waitForDraw(t, sim)
+	if popup.GetText() != "a" {
+		t.Errorf("expected the layer added from the main loop to receive input, got %q", popup.GetText())
+	}
+	app.RemoveLayer(layer)
+	sim.InjectString("b")
+	waitForDraw(t, sim)
+	if popup.GetText() != "a" {
+		t.Errorf("expected the removed layer not to receive input, got %q", popup.GetText())
+	}
+}
@@ -0,0 +1,569 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// ListItem is a single item in a List.
+type ListItem struct {
+	MainText      string
+	SecondaryText string
+	// An optional rune that selects the item when pressed. Zero means no shortcut.
+	Shortcut rune
+	// An optional function which is called when the item is selected.
+	Selected func()
+}
+
+// ListSource provides the items of a List on demand, so that the list doesn't need to hold every item in memory.
+// The list only requests the items it draws, plus the current item when it changes or is selected.
+type ListSource interface {
+	// Count returns the number of items.
+	Count() int
+	// Row returns the item at the given index, which is always between 0 and Count()-1.
+	// A nil item is drawn as an empty line.
+	Row(index int) *ListItem
+}
+
+// List is a scrollable list of items with main and secondary text and optional shortcuts.
+//
+// The current item can be changed with the keys in ListKeymap, the mouse wheel or by clicking.
+// Items are selected with Enter, by clicking them or by pressing their shortcut rune.
+//
+// The list implements Dirtyable, so it's only redrawn during partial redraws when it has changed.
+type List struct {
+	DirtyTracker
+
+	items       []*ListItem
+	source      ListSource
+	currentItem int
+	// The index of the first visible item.
+	offset int
+	// Whether the offset should be adjusted to make the current item visible during the next draw.
+	scrollToCurrent bool
+	// The height of the list and the items that were visible during the last draw.
+	height       int
+	visibleItems []*ListItem
+
+	showSecondaryText bool
+	highlightFullLine bool
+	selectedFocusOnly bool
+	wrapAround        bool
+	focused           bool
+
+	backgroundColor      tcell.Color
+	mainTextColor        tcell.Color
+	secondaryTextColor   tcell.Color
+	shortcutColor        tcell.Color
+	selectedTextColor    tcell.Color
+	selectedBackground   tcell.Color
+	selectedTextAttrMask tcell.AttrMask
+
+	changed  func(index int, item *ListItem)
+	selected func(index int, item *ListItem)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// ListKeymap is the default keymap of lists. It can be overridden per list with SetKeymap.
+var ListKeymap = newDefaultKeymap("list", []string{
+	"previous-item", "next-item", "first-item", "last-item", "page-up", "page-down", "select-item",
+}, map[string]string{
+	"Up":    "previous-item",
+	"Down":  "next-item",
+	"Home":  "first-item",
+	"End":   "last-item",
+	"PgUp":  "page-up",
+	"PgDn":  "page-down",
+	"Enter": "select-item",
+})
+
+// NewList returns a new empty list.
+func NewList() *List {
+	return &List{
+		showSecondaryText:  true,
+		highlightFullLine:  true,
+		backgroundColor:    Styles.PrimitiveBackgroundColor,
+		mainTextColor:      Styles.PrimaryTextColor,
+		secondaryTextColor: Styles.TertiaryTextColor,
+		shortcutColor:      Styles.SecondaryTextColor,
+		selectedTextColor:  Styles.PrimitiveBackgroundColor,
+		selectedBackground: Styles.PrimaryTextColor,
+	}
+}
+
+// AddItem adds a new item to the end of the list.
+func (list *List) AddItem(mainText, secondaryText string, shortcut rune, selected func()) *List {
+	return list.InsertItem(len(list.items), mainText, secondaryText, shortcut, selected)
+}
+
+// InsertItem inserts a new item at the given index. Negative indices count from the end of the list,
+// so -1 is the same as AddItem. Indices out of range are clamped.
+func (list *List) InsertItem(index int, mainText, secondaryText string, shortcut rune, selected func()) *List {
+	list.MarkDirty()
+	index = list.clampIndex(index, len(list.items))
+	item := &ListItem{
+		MainText:      mainText,
+		SecondaryText: secondaryText,
+		Shortcut:      shortcut,
+		Selected:      selected,
+	}
+	list.items = append(list.items, nil)
+	copy(list.items[index+1:], list.items[index:])
+	list.items[index] = item
+	if list.source != nil {
+		return list
+	} else if len(list.items) == 1 {
+		list.currentItem = 0
+		list.notifyChanged()
+	} else if index <= list.currentItem {
+		// Keep the same item selected.
+		list.currentItem++
+	}
+	return list
+}
+
+// RemoveItem removes the item at the given index. Negative indices count from the end of the list.
+func (list *List) RemoveItem(index int) *List {
+	if len(list.items) == 0 {
+		return list
+	}
+	list.MarkDirty()
+	index = list.clampIndex(index, len(list.items)-1)
+	list.items = append(list.items[:index], list.items[index+1:]...)
+	if list.source != nil {
+		return list
+	} else if len(list.items) == 0 {
+		list.currentItem = 0
+		list.offset = 0
+		return list
+	}
+	if index < list.currentItem {
+		// The current item only moved, it didn't change.
+		list.currentItem--
+	} else if index == list.currentItem {
+		list.currentItem = min(list.currentItem, len(list.items)-1)
+		list.notifyChanged()
+	}
+	return list
+}
+
+// Clear removes all items from the list.
+func (list *List) Clear() *List {
+	list.MarkDirty()
+	list.items = nil
+	if list.source == nil {
+		list.currentItem = 0
+		list.offset = 0
+	}
+	return list
+}
+
+// GetItemCount returns the number of items in the list.
+func (list *List) GetItemCount() int {
+	return list.count()
+}
+
+// GetItem returns the item at the given index, or nil if the index is out of range.
+// The fields of the item can be changed, but MarkDirty must be called afterwards to redraw the list.
+func (list *List) GetItem(index int) *ListItem {
+	if index < 0 || index >= list.count() {
+		return nil
+	}
+	return list.item(index)
+}
+
+// GetItemText returns the main and secondary text of the item at the given index.
+func (list *List) GetItemText(index int) (mainText, secondaryText string) {
+	item := list.GetItem(index)
+	if item == nil {
+		return "", ""
+	}
+	return item.MainText, item.SecondaryText
+}
+
+// SetItemText changes the main and secondary text of the item at the given index.
+func (list *List) SetItemText(index int, mainText, secondaryText string) *List {
+	if item := list.GetItem(index); item != nil {
+		list.MarkDirty()
+		item.MainText = mainText
+		item.SecondaryText = secondaryText
+	}
+	return list
+}
+
+// FindItems returns the indices of items whose main or secondary text contains the given strings.
+// Empty strings match nothing.
+// With a custom source, this requests every item from the source.
+func (list *List) FindItems(mainSearch, secondarySearch string) (indices []int) {
+	for index := 0; index < list.count(); index++ {
+		item := list.item(index)
+		if item == nil {
+			continue
+		}
+		if (mainSearch != "" && containsFold(item.MainText, mainSearch)) ||
+			(secondarySearch != "" && containsFold(item.SecondaryText, secondarySearch)) {
+			indices = append(indices, index)
+		}
+	}
+	return
+}
+
+func containsFold(text, search string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(search))
+}
+
+func (list *List) clampIndex(index, maxIndex int) int {
+	if index < 0 {
+		index += maxIndex + 1
+	}
+	return max(0, min(index, maxIndex))
+}
+
+// SetSource makes the list get its items from the given source instead of the items added with AddItem.
+// While a source is set, AddItem, InsertItem, RemoveItem and Clear only change the list's own items,
+// which are shown again if the source is set back to nil.
+// If the content of the source changes, MarkDirty must be called to redraw the list.
+//
+// Setting the source to nil goes back to the items added with AddItem.
+func (list *List) SetSource(source ListSource) *List {
+	list.MarkDirty()
+	list.source = source
+	list.currentItem = 0
+	list.offset = 0
+	list.visibleItems = nil
+	return list
+}
+
+// GetSource returns the source set with SetSource, or nil if the list uses its own items.
+func (list *List) GetSource() ListSource {
+	return list.source
+}
+
+// SetCurrentItem sets the currently selected item. Negative indices count from the end of the list.
+// This calls the changed function if the item changes, but not the selected function.
+func (list *List) SetCurrentItem(index int) *List {
+	if list.count() == 0 {
+		return list
+	}
+	index = list.clampIndex(index, list.count()-1)
+	if index != list.currentItem {
+		list.MarkDirty()
+		list.currentItem = index
+		list.scrollToCurrent = true
+		list.notifyChanged()
+	}
+	return list
+}
+
+// GetCurrentItem returns the index of the currently selected item.
+func (list *List) GetCurrentItem() int {
+	return list.currentItem
+}
+
+// ShowSecondaryText sets whether the secondary text of items is shown below the main text.
+func (list *List) ShowSecondaryText(show bool) *List {
+	list.MarkDirty()
+	list.showSecondaryText = show
+	return list
+}
+
+// SetHighlightFullLine sets whether the selection highlight spans the full width of the list
+// or only the text of the current item.
+func (list *List) SetHighlightFullLine(highlight bool) *List {
+	list.MarkDirty()
+	list.highlightFullLine = highlight
+	return list
+}
+
+// SetSelectedFocusOnly sets whether the current item is only highlighted when the list is focused.
+func (list *List) SetSelectedFocusOnly(focusOnly bool) *List {
+	list.MarkDirty()
+	list.selectedFocusOnly = focusOnly
+	return list
+}
+
+// SetWrapAround sets whether moving past the last item goes back to the first one and vice versa.
+func (list *List) SetWrapAround(wrapAround bool) *List {
+	list.wrapAround = wrapAround
+	return list
+}
+
+func (list *List) SetBackgroundColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.backgroundColor = color
+	return list
+}
+
+func (list *List) SetMainTextColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.mainTextColor = color
+	return list
+}
+
+func (list *List) SetSecondaryTextColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.secondaryTextColor = color
+	return list
+}
+
+func (list *List) SetShortcutColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.shortcutColor = color
+	return list
+}
+
+func (list *List) SetSelectedTextColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.selectedTextColor = color
+	return list
+}
+
+func (list *List) SetSelectedBackgroundColor(color tcell.Color) *List {
+	list.MarkDirty()
+	list.selectedBackground = color
+	return list
+}
+
+func (list *List) SetSelectedTextAttributes(attrs tcell.AttrMask) *List {
+	list.MarkDirty()
+	list.selectedTextAttrMask = attrs
+	return list
+}
+
+// SetChangedFunc sets a function which is called when the current item changes.
+func (list *List) SetChangedFunc(handler func(index int, item *ListItem)) *List {
+	list.changed = handler
+	return list
+}
+
+// SetSelectedFunc sets a function which is called when an item is selected, after the item's own selected function.
+func (list *List) SetSelectedFunc(handler func(index int, item *ListItem)) *List {
+	list.selected = handler
+	return list
+}
+
+// SetKeymap sets the keymap used by this list. If nil, ListKeymap is used.
+func (list *List) SetKeymap(keymap *Keymap) *List {
+	list.keymap = keymap
+	list.keyChord.Reset()
+	return list
+}
+
+func (list *List) notifyChanged() {
+	if list.changed != nil && list.currentItem < list.count() {
+		list.changed(list.currentItem, list.item(list.currentItem))
+	}
+}
+
+// SelectItem selects the item at the given index, which also makes it the current item.
+func (list *List) SelectItem(index int) *List {
+	if index < 0 || index >= list.count() {
+		return list
+	}
+	list.SetCurrentItem(index)
+	item := list.item(index)
+	if item != nil && item.Selected != nil {
+		item.Selected()
+	}
+	if list.selected != nil {
+		list.selected(index, item)
+	}
+	return list
+}
+
+func (list *List) itemHeight() int {
+	if list.showSecondaryText {
+		return 2
+	}
+	return 1
+}
+
+func (list *List) count() int {
+	if list.source != nil {
+		return list.source.Count()
+	}
+	return len(list.items)
+}
+
+func (list *List) item(index int) *ListItem {
+	if list.source != nil {
+		return list.source.Row(index)
+	}
+	return list.items[index]
+}
+
+// findShortcut returns the index of the item with the given shortcut, or -1 if there isn't one.
+// Custom sources can be huge, so only the items that were visible during the last draw are checked for them.
+func (list *List) findShortcut(shortcut rune) int {
+	items, offset := list.items, 0
+	if list.source != nil {
+		items, offset = list.visibleItems, list.offset
+	}
+	for index, item := range items {
+		if item != nil && item.Shortcut == shortcut {
+			return index + offset
+		}
+	}
+	return -1
+}
+
+func (list *List) adjustOffset(height, count int) {
+	visibleItems := max(height/list.itemHeight(), 1)
+	if list.scrollToCurrent {
+		if list.currentItem < list.offset {
+			list.offset = list.currentItem
+		} else if list.currentItem >= list.offset+visibleItems {
+			list.offset = list.currentItem - visibleItems + 1
+		}
+		list.scrollToCurrent = false
+	}
+	list.offset = max(0, min(list.offset, count-visibleItems))
+}
+
+func (list *List) Draw(screen Screen) {
+	width, height := screen.Size()
+	list.height = height
+	screen.SetStyle(tcell.StyleDefault.Background(list.backgroundColor))
+	screen.Clear()
+	list.visibleItems = list.visibleItems[:0]
+	count := list.count()
+	if count == 0 {
+		return
+	}
+	// The source may have shrunk since the last draw.
+	list.currentItem = min(list.currentItem, count-1)
+	list.adjustOffset(height, count)
+
+	textX := 0
+	for index := list.offset; index < count && len(list.visibleItems)*list.itemHeight() < height; index++ {
+		item := list.item(index)
+		list.visibleItems = append(list.visibleItems, item)
+		if item != nil && item.Shortcut != 0 {
+			textX = 4
+		}
+	}
+	baseStyle := tcell.StyleDefault.Background(list.backgroundColor)
+	highlight := list.focused || !list.selectedFocusOnly
+	for i, item := range list.visibleItems {
+		index, y := list.offset+i, i*list.itemHeight()
+		if item == nil {
+			continue
+		}
+		if item.Shortcut != 0 {
+			PrintWithStyle(screen, fmt.Sprintf("(%c)", item.Shortcut), 0, y, 4, AlignLeft, baseStyle.Foreground(list.shortcutColor))
+		}
+		mainStyle := baseStyle.Foreground(list.mainTextColor)
+		if index == list.currentItem && highlight {
+			mainStyle = tcell.StyleDefault.
+				Foreground(list.selectedTextColor).
+				Background(list.selectedBackground).
+				Attributes(list.selectedTextAttrMask)
+			highlightWidth := width - textX
+			if !list.highlightFullLine {
+				highlightWidth = min(TaggedStringWidth(item.MainText), highlightWidth)
+			}
+			for x := textX; x < textX+highlightWidth; x++ {
+				screen.SetContent(x, y, ' ', nil, mainStyle)
+			}
+		}
+		PrintWithStyle(screen, item.MainText, textX, y, width-textX, AlignLeft, mainStyle)
+		if list.showSecondaryText && y+1 < height {
+			PrintWithStyle(screen, item.SecondaryText, textX, y+1, width-textX, AlignLeft, baseStyle.Foreground(list.secondaryTextColor))
+		}
+	}
+}
+
+func (list *List) moveCurrent(delta int) {
+	if list.count() == 0 {
+		return
+	}
+	index := list.currentItem + delta
+	if list.wrapAround && (delta == 1 || delta == -1) {
+		index = (index + list.count()) % list.count()
+	}
+	list.SetCurrentItem(max(0, min(index, list.count()-1)))
+}
+
+func (list *List) OnKeyEvent(event KeyEvent) bool {
+	keymap := list.keymap
+	if keymap == nil {
+		keymap = ListKeymap
+	}
+	action, consumed := keymap.Process(&list.keyChord, event)
+	if !consumed {
+		if event.Key() == tcell.KeyRune {
+			if index := list.findShortcut(event.Rune()); index >= 0 {
+				list.SelectItem(index)
+				return true
+			}
+		}
+		return false
+	}
+	pageSize := max(list.height/list.itemHeight(), 1)
+	switch action {
+	case "previous-item":
+		list.moveCurrent(-1)
+	case "next-item":
+		list.moveCurrent(1)
+	case "first-item":
+		list.SetCurrentItem(0)
+	case "last-item":
+		list.SetCurrentItem(-1)
+	case "page-up":
+		list.moveCurrent(-pageSize)
+	case "page-down":
+		list.moveCurrent(pageSize)
+	case "select-item":
+		list.SelectItem(list.currentItem)
+	}
+	return true
+}
+
+func (list *List) OnMouseEvent(event MouseEvent) bool {
+	switch event.Buttons() {
+	case tcell.Button1:
+		if event.HasMotion() {
+			return false
+		}
+		_, y := event.Position()
+		index := list.offset + y/list.itemHeight()
+		if y < 0 || index >= list.count() {
+			return false
+		}
+		list.SelectItem(index)
+	case tcell.WheelUp:
+		list.MarkDirty()
+		list.offset--
+	case tcell.WheelDown:
+		list.MarkDirty()
+		list.offset++
+	default:
+		return false
+	}
+	return true
+}
+
+func (list *List) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
+
+func (list *List) Focus() {
+	list.MarkDirty()
+	list.focused = true
+}
+
+func (list *List) Blur() {
+	list.MarkDirty()
+	list.focused = false
+}
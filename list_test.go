@@ -0,0 +1,177 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func newTestList(count int) *List {
+	list := NewList()
+	for i := 0; i < count; i++ {
+		list.AddItem(fmt.Sprintf("item %d", i), fmt.Sprintf("secondary %d", i), rune('0'+i), nil)
+	}
+	return list
+}
+
+func TestList_Keys(t *testing.T) {
+	list := newTestList(10)
+	var changes, selections []int
+	list.SetChangedFunc(func(index int, item *ListItem) {
+		changes = append(changes, index)
+	}).SetSelectedFunc(func(index int, item *ListItem) {
+		selections = append(selections, index)
+	})
+	// Render once so that page-up and page-down know the height.
+	RenderSnapshot(list, 20, 6)
+	key := func(key tcell.Key) {
+		if !list.OnKeyEvent(tcell.NewEventKey(key, 0, tcell.ModNone)) {
+			t.Errorf("expected key %v to be handled", key)
+		}
+	}
+
+	key(tcell.KeyDown)
+	key(tcell.KeyDown)
+	key(tcell.KeyPgDn)
+	key(tcell.KeyUp)
+	key(tcell.KeyEnd)
+	key(tcell.KeyDown)
+	key(tcell.KeyHome)
+	key(tcell.KeyEnter)
+	if !slices.Equal(changes, []int{1, 2, 5, 4, 9, 0}) {
+		t.Errorf("unexpected changed callbacks %v", changes)
+	}
+	if !slices.Equal(selections, []int{0}) {
+		t.Errorf("unexpected selected callbacks %v", selections)
+	}
+
+	if !list.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, '7', tcell.ModNone)) || list.GetCurrentItem() != 7 {
+		t.Errorf("expected shortcut to select item 7, current item is %d", list.GetCurrentItem())
+	}
+	if list.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone)) {
+		t.Errorf("expected unbound rune without a matching shortcut not to be handled")
+	}
+	if !slices.Equal(selections, []int{0, 7}) {
+		t.Errorf("unexpected selected callbacks %v", selections)
+	}
+}
+
+func TestList_WrapAround(t *testing.T) {
+	list := newTestList(3).SetWrapAround(true)
+	list.OnKeyEvent(tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone))
+	if current := list.GetCurrentItem(); current != 2 {
+		t.Errorf("expected Up to wrap to the last item, got %d", current)
+	}
+	list.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	if current := list.GetCurrentItem(); current != 0 {
+		t.Errorf("expected Down to wrap to the first item, got %d", current)
+	}
+}
+
+func TestList_InsertAndRemoveKeepCurrentItem(t *testing.T) {
+	list := newTestList(5).SetCurrentItem(2)
+	var changes []int
+	list.SetChangedFunc(func(index int, item *ListItem) {
+		changes = append(changes, index)
+	})
+
+	list.InsertItem(0, "new", "", 0, nil)
+	if current := list.GetCurrentItem(); current != 3 {
+		t.Errorf("expected inserting before the current item to shift it to 3, got %d", current)
+	}
+	list.RemoveItem(-1)
+	list.RemoveItem(0)
+	if main, _ := list.GetItemText(list.GetCurrentItem()); main != "item 2" {
+		t.Errorf("expected the current item to stay the same, got %q", main)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changed callbacks when the current item only moves, got %v", changes)
+	}
+	list.RemoveItem(list.GetCurrentItem())
+	if main, _ := list.GetItemText(list.GetCurrentItem()); main != "item 3" || !slices.Equal(changes, []int{2}) {
+		t.Errorf("expected removing the current item to select the next one, got %q and %v", main, changes)
+	}
+	if found := list.FindItems("ITEM 3", "secondary 0"); !slices.Equal(found, []int{0, 2}) {
+		t.Errorf("expected case-insensitive search to match either text, got %v", found)
+	}
+	list.Clear()
+	if list.GetItemCount() != 0 || list.GetCurrentItem() != 0 {
+		t.Errorf("expected Clear to remove all items")
+	}
+}
+
+func TestList_Mouse(t *testing.T) {
+	list := newTestList(10)
+	selected := -1
+	list.SetSelectedFunc(func(index int, item *ListItem) {
+		selected = index
+	})
+	RenderSnapshot(list, 20, 4)
+	list.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(0, 0, tcell.WheelDown, tcell.ModNone), false})
+	list.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(5, 3, tcell.Button1, tcell.ModNone), false})
+	if selected != 2 || list.GetCurrentItem() != 2 {
+		t.Errorf("expected clicking the second row after scrolling to select item 2, got %d", selected)
+	}
+	if list.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(5, 30, tcell.Button1, tcell.ModNone), false}) {
+		t.Errorf("expected clicking below the last item not to be handled")
+	}
+}
+
+func TestGolden_List(t *testing.T) {
+	list := newTestList(5).SetCurrentItem(3)
+	list.SetItemText(2, "[red]tagged[-] item", "")
+	assertGolden(t, goldenPath("list"), list, 16, 4)
+}
+
+type countingListSource struct {
+	count    int
+	requests []int
+}
+
+func (source *countingListSource) Count() int {
+	return source.count
+}
+
+func (source *countingListSource) Row(index int) *ListItem {
+	source.requests = append(source.requests, index)
+	return &ListItem{MainText: fmt.Sprintf("item %d", index), Shortcut: rune('a' + index%26)}
+}
+
+func TestList_SourceOnlyRequestsVisibleItems(t *testing.T) {
+	source := &countingListSource{count: 1_000_000}
+	list := NewList().ShowSecondaryText(false).SetSource(source).SetCurrentItem(500_000)
+	source.requests = nil
+	RenderSnapshot(list, 20, 4)
+	if !slices.Equal(source.requests, []int{499_997, 499_998, 499_999, 500_000}) {
+		t.Errorf("expected only the visible items to be requested, got %v", source.requests)
+	}
+
+	// Shortcuts only apply to the visible items.
+	if !list.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 't', tcell.ModNone)) || list.GetCurrentItem() != 499_999 {
+		t.Errorf("expected shortcut of a visible item to select it, current item is %d", list.GetCurrentItem())
+	}
+	if list.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'z', tcell.ModNone)) {
+		t.Errorf("expected shortcut of an item that isn't visible not to be handled")
+	}
+
+	// The list's own items are kept while the source is set.
+	list.AddItem("own item", "", 0, nil)
+	source.count = 2
+	RenderSnapshot(list, 20, 4)
+	if current := list.GetCurrentItem(); current != 1 {
+		t.Errorf("expected the current item to be clamped when the source shrinks, got %d", current)
+	}
+	list.SetSource(nil)
+	if main, _ := list.GetItemText(0); list.GetItemCount() != 1 || main != "own item" {
+		t.Errorf("expected the list's own items to be shown after removing the source")
+	}
+}
@@ -0,0 +1,78 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package mauviewtest contains helpers for testing mauview components with golden files.
+//
+// Snapshots are created with mauview.RenderSnapshot or mauview.Simulation.Snapshot. Tests usually decide whether
+// to update the golden files based on a command-line flag:
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	func TestBox(t *testing.T) {
+//		snapshot := mauview.RenderSnapshot(mauview.NewBox(nil), 10, 3)
+//		mauviewtest.AssertGolden(t, "testdata/box.golden", snapshot, *update)
+//	}
+package mauviewtest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TB is the subset of testing.TB used by AssertGolden.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
+
+// AssertGolden compares the given snapshot to the golden file at the given path.
+// If update is true, the golden file is rewritten instead.
+func AssertGolden(t TB, path, snapshot string, update bool) {
+	t.Helper()
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+		} else if err = os.WriteFile(path, []byte(snapshot), 0644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("golden file %s doesn't exist (update the golden files to create it)", path)
+		return
+	} else if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+		return
+	}
+	if string(expected) != snapshot {
+		t.Fatalf("snapshot doesn't match golden file %s\n%s", path, diffSnapshots(string(expected), snapshot))
+	}
+}
+
+// diffSnapshots returns a short description of the lines that differ between the two snapshots.
+func diffSnapshots(expected, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+	var out strings.Builder
+	for i := 0; i < len(expectedLines) || i < len(actualLines); i++ {
+		var exp, act string
+		if i < len(expectedLines) {
+			exp = expectedLines[i]
+		}
+		if i < len(actualLines) {
+			act = actualLines[i]
+		}
+		if exp != act {
+			_, _ = fmt.Fprintf(&out, "line %d:\n  expected: %s\n  actual:   %s\n", i+1, exp, act)
+		}
+	}
+	return out.String()
+}
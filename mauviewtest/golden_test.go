@@ -0,0 +1,43 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauviewtest
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type recordingTB struct {
+	failure string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Fatalf(format string, args ...interface{}) {
+	tb.failure = fmt.Sprintf(format, args...)
+}
+
+func TestAssertGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "snapshot.golden")
+	var tb recordingTB
+	if AssertGolden(&tb, path, "|a|\n", false); !strings.Contains(tb.failure, "doesn't exist") {
+		t.Errorf("expected a missing golden file to fail, got %q", tb.failure)
+	}
+	tb = recordingTB{}
+	if AssertGolden(&tb, path, "|a|\n|b|\n", true); tb.failure != "" {
+		t.Fatalf("expected updating the golden file to succeed, got %q", tb.failure)
+	}
+	if AssertGolden(&tb, path, "|a|\n|b|\n", false); tb.failure != "" {
+		t.Errorf("expected a matching snapshot to pass, got %q", tb.failure)
+	}
+	if AssertGolden(&tb, path, "|a|\n|c|\n", false); !strings.Contains(tb.failure, "line 2:\n  expected: |b|\n  actual:   |c|\n") {
+		t.Errorf("expected the differing line to be reported, got %q", tb.failure)
+	}
+}
@@ -0,0 +1,438 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// MenuItem is a single entry in a Menu.
+type MenuItem struct {
+	// The text of the item. Color tags are not supported.
+	Label string
+	// A key that activates the item while the menu is open. The first occurrence of it in the label is underlined.
+	Accelerator rune
+	// Text drawn on the right side of the item, usually the application-wide shortcut of the same action.
+	Hint string
+	// Disabled items are drawn dimmed and can't be activated.
+	Disabled bool
+	// Separators are drawn as a horizontal line and ignore all other fields.
+	Separator bool
+	// A menu that is opened next to this menu when the item is activated.
+	Submenu *Menu
+	// A function that is called when the item is activated.
+	Selected func()
+}
+
+func (item *MenuItem) selectable() bool {
+	return !item.Separator && !item.Disabled
+}
+
+// Menu is a list of menu items, shown in a MenuBar or a ContextMenu.
+//
+// Menus can be modified while they're open, the popup is redrawn with the new items.
+type Menu struct {
+	title       string
+	accelerator rune
+	items       []*MenuItem
+}
+
+// NewMenu returns a new empty menu. The title and accelerator are only used when the menu is added to a MenuBar,
+// where pressing the accelerator opens the menu.
+func NewMenu(title string, accelerator rune) *Menu {
+	return &Menu{title: title, accelerator: accelerator}
+}
+
+func (menu *Menu) GetTitle() string {
+	return menu.title
+}
+
+// AddItem adds an item that calls the given function when activated.
+func (menu *Menu) AddItem(label string, accelerator rune, selected func()) *Menu {
+	return menu.AddMenuItem(&MenuItem{Label: label, Accelerator: accelerator, Selected: selected})
+}
+
+// AddSubmenu adds an item that opens another menu when activated.
+func (menu *Menu) AddSubmenu(label string, accelerator rune, submenu *Menu) *Menu {
+	return menu.AddMenuItem(&MenuItem{Label: label, Accelerator: accelerator, Submenu: submenu})
+}
+
+// AddSeparator adds a horizontal line.
+func (menu *Menu) AddSeparator() *Menu {
+	return menu.AddMenuItem(&MenuItem{Separator: true})
+}
+
+// AddMenuItem adds an item with all fields set by the caller.
+func (menu *Menu) AddMenuItem(item *MenuItem) *Menu {
+	menu.items = append(menu.items, item)
+	return menu
+}
+
+func (menu *Menu) GetItems() []*MenuItem {
+	return menu.items
+}
+
+// GetItem returns the item at the given index, or nil if the index is out of range.
+func (menu *Menu) GetItem(index int) *MenuItem {
+	if index < 0 || index >= len(menu.items) {
+		return nil
+	}
+	return menu.items[index]
+}
+
+// Clear removes all items from the menu.
+func (menu *Menu) Clear() *Menu {
+	menu.items = nil
+	return menu
+}
+
+// findAccelerator returns the index of the first selectable item with the given accelerator, or -1.
+func (menu *Menu) findAccelerator(char rune) int {
+	char = unicode.ToLower(char)
+	for i, item := range menu.items {
+		if item.selectable() && item.Accelerator != 0 && unicode.ToLower(item.Accelerator) == char {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextSelectable returns the index of the next selectable item from the given index in the given direction,
+// or -1 if there isn't one. The search wraps around.
+func (menu *Menu) nextSelectable(from, direction int) int {
+	count := len(menu.items)
+	for i := 1; i <= count; i++ {
+		index := ((from+direction*i)%count + count) % count
+		if menu.items[index].selectable() {
+			return index
+		}
+	}
+	return -1
+}
+
+// MenuKeymap is the default keymap of open menus.
+// Unbound characters activate the item with that accelerator.
+var MenuKeymap = newDefaultKeymap("menu", []string{
+	"previous-item", "next-item", "first-item", "last-item", "open-submenu", "close-submenu", "select-item", "close-menu",
+}, map[string]string{
+	"Up":    "previous-item",
+	"Down":  "next-item",
+	"Home":  "first-item",
+	"End":   "last-item",
+	"Right": "open-submenu",
+	"Left":  "close-submenu",
+	"Enter": "select-item",
+	"Space": "select-item",
+	"Esc":   "close-menu",
+})
+
+// MenuStyles defines the styles used when open menus are drawn.
+type MenuStyles struct {
+	Normal   tcell.Style
+	Selected tcell.Style
+	Disabled tcell.Style
+	Border   tcell.Style
+}
+
+// DefaultMenuStyles returns the default menu styles based on Styles.
+func DefaultMenuStyles() MenuStyles {
+	base := tcell.StyleDefault.Background(Styles.ContrastBackgroundColor)
+	return MenuStyles{
+		Normal:   base.Foreground(Styles.PrimaryTextColor),
+		Selected: base.Foreground(Styles.PrimaryTextColor).Reverse(true),
+		Disabled: base.Foreground(Styles.ContrastSecondaryTextColor),
+		Border:   base.Foreground(Styles.BorderColor),
+	}
+}
+
+// printAccelerated prints a plain label and underlines the first occurrence of the accelerator in it.
+func printAccelerated(screen Screen, label string, accelerator rune, x, y, maxWidth int, style tcell.Style) {
+	PrintWithStyle(screen, Escape(label), x, y, maxWidth, AlignLeft, style)
+	if accelerator == 0 {
+		return
+	}
+	index := strings.IndexFunc(label, func(r rune) bool {
+		return unicode.ToLower(r) == unicode.ToLower(accelerator)
+	})
+	if pos := stringWidth(label[:max(index, 0)]); index >= 0 && pos < maxWidth {
+		mainc, combc, cellStyle, _ := screen.GetContent(x+pos, y)
+		screen.SetContent(x+pos, y, mainc, combc, cellStyle.Underline(true))
+	}
+}
+
+type menuLevel struct {
+	menu   *Menu
+	cursor int
+	// The area of the menu box including the border on the root screen during the last draw.
+	area Rect
+}
+
+// menuPopup shows an open menu and its open submenus. Like the DropDown popup, it covers the whole screen in
+// a non-modal layer, so it can close itself when something outside it is clicked while letting the click through.
+type menuPopup struct {
+	app    *Application
+	styles MenuStyles
+	levels []*menuLevel
+	layer  *Layer
+	// The position of the top-left corner of the first menu on the root screen.
+	anchorX, anchorY int
+	// The area of the component that opened the popup, e.g. a menu bar title. Clicking it only closes the popup.
+	ownerArea Rect
+
+	// Called when Left or Right doesn't open or close a submenu, used by MenuBar to switch between menus.
+	moveSideways func(direction int)
+	closed       func()
+	keyChord     ChordState
+	// Removing the layer goes through the application's update queue, so a closed popup may still receive a few
+	// events or draws before it's actually gone. They're ignored.
+	isClosed bool
+}
+
+func newMenuPopup(app *Application, menu *Menu, x, y int, styles MenuStyles) *menuPopup {
+	popup := &menuPopup{app: app, styles: styles, anchorX: x, anchorY: y}
+	popup.setMenu(menu, x, y)
+	popup.layer = NewLayer(popup).SetZIndex(PopupZIndex)
+	return popup
+}
+
+// setMenu replaces the open menus with the given menu.
+func (popup *menuPopup) setMenu(menu *Menu, x, y int) {
+	popup.anchorX, popup.anchorY = x, y
+	popup.levels = []*menuLevel{{menu: menu, cursor: menu.nextSelectable(-1, 1)}}
+}
+
+func (popup *menuPopup) close() {
+	if popup.isClosed {
+		return
+	}
+	popup.isClosed = true
+	popup.app.RemoveLayer(popup.layer)
+	if popup.closed != nil {
+		popup.closed()
+	}
+}
+
+func (popup *menuPopup) current() *menuLevel {
+	return popup.levels[len(popup.levels)-1]
+}
+
+// activate opens the submenu of the item at the cursor of the deepest menu, or closes all menus and calls the
+// selected function of the item.
+func (popup *menuPopup) activate() {
+	level := popup.current()
+	item := level.menu.GetItem(level.cursor)
+	if item == nil || !item.selectable() {
+		return
+	} else if item.Submenu != nil {
+		popup.levels = append(popup.levels, &menuLevel{menu: item.Submenu, cursor: item.Submenu.nextSelectable(-1, 1)})
+		return
+	}
+	popup.close()
+	if item.Selected != nil {
+		item.Selected()
+	}
+}
+
+func (popup *menuPopup) menuSize(menu *Menu) (width, height int) {
+	labelWidth, hintWidth, hasSubmenus := 0, 0, false
+	for _, item := range menu.items {
+		labelWidth = max(labelWidth, stringWidth(item.Label))
+		hintWidth = max(hintWidth, stringWidth(item.Hint))
+		hasSubmenus = hasSubmenus || item.Submenu != nil
+	}
+	if hintWidth > 0 {
+		labelWidth += hintWidth + 2
+	}
+	if hasSubmenus {
+		labelWidth += stringWidth(Glyphs.MenuSubmenu) + 1
+	}
+	// The border and one cell of padding on both sides.
+	return labelWidth + 4, len(menu.items) + 2
+}
+
+func (popup *menuPopup) Draw(screen Screen) {
+	if popup.isClosed {
+		return
+	}
+	screenWidth, screenHeight := screen.Size()
+	for i, level := range popup.levels {
+		width, height := popup.menuSize(level.menu)
+		width, height = min(width, screenWidth), min(height, screenHeight)
+		x, y := popup.anchorX, popup.anchorY
+		if i > 0 {
+			parent := popup.levels[i-1].area
+			x, y = parent.X+parent.Width, parent.Y+1+popup.levels[i-1].cursor
+			if x+width > screenWidth && parent.X-width >= 0 {
+				// Not enough space on the right, so open to the left of the parent menu.
+				x = parent.X - width
+			}
+		}
+		level.area = Rect{
+			X:      max(0, min(x, screenWidth-width)),
+			Y:      max(0, min(y, screenHeight-height)),
+			Width:  width,
+			Height: height,
+		}
+		popup.drawMenu(&ProxyScreen{
+			Parent:  screen,
+			OffsetX: level.area.X,
+			OffsetY: level.area.Y,
+			Width:   level.area.Width,
+			Height:  level.area.Height,
+			Style:   popup.styles.Normal,
+		}, level)
+	}
+}
+
+func (popup *menuPopup) drawMenu(screen Screen, level *menuLevel) {
+	width, height := screen.Size()
+	screen.Clear()
+	border := popup.styles.Border
+	for x := 1; x < width-1; x++ {
+		screen.SetContent(x, 0, Borders.Horizontal, nil, border)
+		screen.SetContent(x, height-1, Borders.Horizontal, nil, border)
+	}
+	for y := 1; y < height-1; y++ {
+		screen.SetContent(0, y, Borders.Vertical, nil, border)
+		screen.SetContent(width-1, y, Borders.Vertical, nil, border)
+	}
+	screen.SetContent(0, 0, Borders.TopLeft, nil, border)
+	screen.SetContent(width-1, 0, Borders.TopRight, nil, border)
+	screen.SetContent(0, height-1, Borders.BottomLeft, nil, border)
+	screen.SetContent(width-1, height-1, Borders.BottomRight, nil, border)
+
+	innerWidth := width - 4
+	for i, item := range level.menu.items {
+		y := i + 1
+		if y >= height-1 {
+			break
+		}
+		if item.Separator {
+			screen.SetContent(0, y, Borders.LeftT, nil, border)
+			screen.SetContent(width-1, y, Borders.RightT, nil, border)
+			for x := 1; x < width-1; x++ {
+				screen.SetContent(x, y, Borders.Horizontal, nil, border)
+			}
+			continue
+		}
+		style := popup.styles.Normal
+		if item.Disabled {
+			style = popup.styles.Disabled
+		} else if i == level.cursor {
+			style = popup.styles.Selected
+		}
+		for x := 1; x < width-1; x++ {
+			screen.SetContent(x, y, ' ', nil, style)
+		}
+		rightWidth := 0
+		if item.Submenu != nil {
+			rightWidth = stringWidth(Glyphs.MenuSubmenu)
+			PrintWithStyle(screen, Escape(Glyphs.MenuSubmenu), 2+innerWidth-rightWidth, y, rightWidth, AlignLeft, style)
+			rightWidth++
+		}
+		if item.Hint != "" {
+			PrintWithStyle(screen, Escape(item.Hint), 2, y, innerWidth-rightWidth, AlignRight, style)
+			rightWidth += stringWidth(item.Hint) + 2
+		}
+		printAccelerated(screen, item.Label, item.Accelerator, 2, y, innerWidth-rightWidth, style)
+	}
+}
+
+func (popup *menuPopup) OnKeyEvent(event KeyEvent) bool {
+	if popup.isClosed {
+		return false
+	}
+	level := popup.current()
+	action, consumed := MenuKeymap.Process(&popup.keyChord, event)
+	switch action {
+	case "previous-item", "next-item", "first-item", "last-item":
+		from, direction := level.cursor, 1
+		switch action {
+		case "previous-item":
+			direction = -1
+		case "first-item":
+			from = -1
+		case "last-item":
+			from, direction = len(level.menu.items), -1
+		}
+		if index := level.menu.nextSelectable(from, direction); index >= 0 {
+			level.cursor = index
+		}
+	case "open-submenu":
+		if item := level.menu.GetItem(level.cursor); item != nil && item.selectable() && item.Submenu != nil {
+			popup.activate()
+		} else if popup.moveSideways != nil {
+			popup.moveSideways(1)
+		}
+	case "close-submenu":
+		if len(popup.levels) > 1 {
+			popup.levels = popup.levels[:len(popup.levels)-1]
+		} else if popup.moveSideways != nil {
+			popup.moveSideways(-1)
+		}
+	case "select-item":
+		popup.activate()
+	case "close-menu":
+		if len(popup.levels) > 1 {
+			popup.levels = popup.levels[:len(popup.levels)-1]
+		} else {
+			popup.close()
+		}
+	case "":
+		if consumed {
+			break
+		}
+		switch event.Key() {
+		case tcell.KeyTab, tcell.KeyBacktab:
+			// Let the key through so that it moves the focus onwards.
+			popup.close()
+			return false
+		case tcell.KeyRune:
+			if index := level.menu.findAccelerator(event.Rune()); index >= 0 {
+				level.cursor = index
+				popup.activate()
+			}
+		}
+	}
+	// The popup captures all other keys while it's open.
+	return true
+}
+
+func (popup *menuPopup) OnMouseEvent(event MouseEvent) bool {
+	if popup.isClosed {
+		return false
+	}
+	x, y := event.Position()
+	for i := len(popup.levels) - 1; i >= 0; i-- {
+		level := popup.levels[i]
+		if !level.area.Contains(x, y) {
+			continue
+		}
+		if event.Buttons() == tcell.Button1 && !event.HasMotion() {
+			index := y - level.area.Y - 1
+			if item := level.menu.GetItem(index); item != nil && item.selectable() {
+				popup.levels = popup.levels[:i+1]
+				level.cursor = index
+				popup.activate()
+			}
+		}
+		return true
+	}
+	if event.Buttons() != tcell.ButtonNone && !event.HasMotion() {
+		popup.close()
+		return popup.ownerArea.Contains(x, y)
+	}
+	return false
+}
+
+func (popup *menuPopup) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
@@ -0,0 +1,266 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// MenuBar is a single-line bar of menu titles, each of which opens a drop-down Menu when clicked.
+//
+// Like DropDown, the menus are drawn in a layer above everything else, so the menu bar needs a reference to
+// the application (see SetApplication). While a menu is open, Left and Right switch to the neighbouring menus.
+//
+// When the menu bar itself is focused, Left and Right move between titles, Enter or Down opens a menu, and the
+// accelerator of a menu (with or without Alt) opens it directly. To open menus from anywhere, bind keys to
+// application-wide actions that call OpenMenu:
+//
+//	app.SetKeyAction("open-file-menu", func() bool {
+//		menuBar.OpenMenu(0)
+//		return true
+//	})
+//	app.Keymap().MustBind("Alt+f", "open-file-menu")
+type MenuBar struct {
+	DirtyTracker
+
+	app        *Application
+	menus      []*Menu
+	titleAreas []Rect
+	// The area of the menu bar on the root screen during the last draw, used to position the menus.
+	area    Rect
+	open    int
+	cursor  int
+	focused bool
+	popup   *menuPopup
+
+	style         tcell.Style
+	selectedStyle tcell.Style
+	menuStyles    MenuStyles
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// MenuBarKeymap is the default keymap of focused menu bars. It can be overridden per menu bar with SetKeymap.
+// Open menus use MenuKeymap.
+var MenuBarKeymap = newDefaultKeymap("menu-bar", []string{
+	"previous-menu", "next-menu", "open-menu",
+}, map[string]string{
+	"Left":  "previous-menu",
+	"Right": "next-menu",
+	"Enter": "open-menu",
+	"Down":  "open-menu",
+	"Space": "open-menu",
+})
+
+// NewMenuBar returns a new menu bar with no menus.
+func NewMenuBar() *MenuBar {
+	return &MenuBar{
+		open:          -1,
+		style:         tcell.StyleDefault.Background(Styles.ContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		selectedStyle: tcell.StyleDefault.Background(Styles.PrimaryTextColor).Foreground(Styles.ContrastBackgroundColor),
+		menuStyles:    DefaultMenuStyles(),
+	}
+}
+
+// SetApplication sets the application whose layer stack the menus are shown in.
+func (bar *MenuBar) SetApplication(app *Application) *MenuBar {
+	bar.app = app
+	return bar
+}
+
+// AddMenu adds a menu to the end of the bar. The title and accelerator of the menu are shown in the bar.
+func (bar *MenuBar) AddMenu(menu *Menu) *MenuBar {
+	bar.MarkDirty()
+	bar.menus = append(bar.menus, menu)
+	return bar
+}
+
+// RemoveMenu removes the given menu from the bar, closing it first if it's open.
+func (bar *MenuBar) RemoveMenu(menu *Menu) *MenuBar {
+	for i, barMenu := range bar.menus {
+		if barMenu == menu {
+			if i == bar.open {
+				bar.CloseMenu()
+			}
+			bar.MarkDirty()
+			bar.menus = append(bar.menus[:i], bar.menus[i+1:]...)
+			bar.cursor = max(0, min(bar.cursor, len(bar.menus)-1))
+			break
+		}
+	}
+	return bar
+}
+
+func (bar *MenuBar) GetMenus() []*Menu {
+	return bar.menus
+}
+
+func (bar *MenuBar) SetStyle(style tcell.Style) *MenuBar {
+	bar.MarkDirty()
+	bar.style = style
+	return bar
+}
+
+// SetSelectedStyle sets the style of the title of the open menu, and of the title under the cursor
+// while the bar is focused.
+func (bar *MenuBar) SetSelectedStyle(style tcell.Style) *MenuBar {
+	bar.MarkDirty()
+	bar.selectedStyle = style
+	return bar
+}
+
+// SetMenuStyles sets the styles of the open menus.
+func (bar *MenuBar) SetMenuStyles(styles MenuStyles) *MenuBar {
+	bar.menuStyles = styles
+	return bar
+}
+
+// SetKeymap sets the keymap used by this menu bar while it's focused. If nil, MenuBarKeymap is used.
+func (bar *MenuBar) SetKeymap(keymap *Keymap) *MenuBar {
+	bar.keymap = keymap
+	bar.keyChord.Reset()
+	return bar
+}
+
+// IsOpen returns true if one of the menus is currently open.
+func (bar *MenuBar) IsOpen() bool {
+	return bar.popup != nil
+}
+
+// OpenMenu opens the menu at the given index, closing any other open menu.
+// It does nothing if the application hasn't been set.
+func (bar *MenuBar) OpenMenu(index int) {
+	if bar.app == nil || index < 0 || index >= len(bar.menus) || index == bar.open {
+		return
+	}
+	bar.MarkDirty()
+	bar.open, bar.cursor = index, index
+	var titleArea Rect
+	if index < len(bar.titleAreas) {
+		titleArea = bar.titleAreas[index]
+	}
+	titleArea.X += bar.area.X
+	titleArea.Y += bar.area.Y
+	if bar.popup != nil {
+		bar.popup.setMenu(bar.menus[index], titleArea.X, titleArea.Y+1)
+		bar.popup.ownerArea = titleArea
+		return
+	}
+	popup := newMenuPopup(bar.app, bar.menus[index], titleArea.X, titleArea.Y+1, bar.menuStyles)
+	popup.ownerArea = titleArea
+	popup.moveSideways = func(direction int) {
+		count := len(bar.menus)
+		bar.OpenMenu((bar.open + direction + count) % count)
+	}
+	popup.closed = func() {
+		bar.MarkDirty()
+		bar.popup = nil
+		bar.open = -1
+	}
+	bar.popup = popup
+	bar.app.AddLayer(popup.layer)
+}
+
+// CloseMenu closes the open menu, if any.
+func (bar *MenuBar) CloseMenu() {
+	if bar.popup != nil {
+		bar.popup.close()
+	}
+}
+
+func (bar *MenuBar) Focus() {
+	bar.MarkDirty()
+	bar.focused = true
+}
+
+func (bar *MenuBar) Blur() {
+	bar.MarkDirty()
+	bar.focused = false
+}
+
+func (bar *MenuBar) Draw(screen Screen) {
+	width, _ := screen.Size()
+	bar.area = absoluteArea(screen)
+	screen.SetStyle(bar.style)
+	screen.Clear()
+	bar.titleAreas = bar.titleAreas[:0]
+	x := 0
+	for i, menu := range bar.menus {
+		area := Rect{X: x, Y: 0, Width: stringWidth(menu.title) + 2, Height: 1}
+		bar.titleAreas = append(bar.titleAreas, area)
+		x += area.Width
+		if area.X >= width {
+			continue
+		}
+		style := bar.style
+		if i == bar.open || (bar.open < 0 && bar.focused && i == bar.cursor) {
+			style = bar.selectedStyle
+			for cx := area.X; cx < area.X+area.Width && cx < width; cx++ {
+				screen.SetContent(cx, 0, ' ', nil, style)
+			}
+		}
+		printAccelerated(screen, menu.title, menu.accelerator, area.X+1, 0, min(area.Width-2, width-area.X-1), style)
+	}
+}
+
+func (bar *MenuBar) OnKeyEvent(event KeyEvent) bool {
+	keymap := bar.keymap
+	if keymap == nil {
+		keymap = MenuBarKeymap
+	}
+	action, consumed := keymap.Process(&bar.keyChord, event)
+	switch action {
+	case "previous-menu":
+		if bar.cursor > 0 {
+			bar.MarkDirty()
+			bar.cursor--
+		}
+	case "next-menu":
+		if bar.cursor < len(bar.menus)-1 {
+			bar.MarkDirty()
+			bar.cursor++
+		}
+	case "open-menu":
+		bar.OpenMenu(bar.cursor)
+	case "":
+		if !consumed && event.Key() == tcell.KeyRune && event.Modifiers()&tcell.ModCtrl == 0 {
+			char := unicode.ToLower(event.Rune())
+			for i, menu := range bar.menus {
+				if menu.accelerator != 0 && unicode.ToLower(menu.accelerator) == char {
+					bar.OpenMenu(i)
+					return true
+				}
+			}
+		}
+	}
+	return consumed
+}
+
+func (bar *MenuBar) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() != tcell.Button1 || event.HasMotion() {
+		return false
+	}
+	for i, area := range bar.titleAreas {
+		if area.Contains(event.Position()) {
+			if i == bar.open {
+				bar.CloseMenu()
+			} else {
+				bar.OpenMenu(i)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (bar *MenuBar) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
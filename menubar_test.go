@@ -0,0 +1,112 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func startMenuBarSimulation(t *testing.T) (*Simulation, *MenuBar, *[]string) {
+	var activated []string
+	item := func(name string) func() {
+		return func() {
+			activated = append(activated, name)
+		}
+	}
+	recent := NewMenu("", 0).
+		AddItem("a.txt", 'a', item("a.txt")).
+		AddItem("b.txt", 'b', item("b.txt"))
+	file := NewMenu("File", 'f').
+		AddItem("Open", 'o', item("open")).
+		AddMenuItem(&MenuItem{Label: "Save", Accelerator: 's', Hint: "Ctrl+S", Selected: item("save")}).
+		AddMenuItem(&MenuItem{Label: "Print", Accelerator: 'p', Disabled: true, Selected: item("print")}).
+		AddSubmenu("Recent", 'r', recent).
+		AddSeparator().
+		AddItem("Quit", 'q', item("quit"))
+	edit := NewMenu("Edit", 'e').AddItem("Copy", 'c', item("copy"))
+	bar := NewMenuBar().AddMenu(file).AddMenu(edit)
+	root := NewFlex().SetDirection(FlexRow).
+		AddFixedComponent(bar, 1).
+		AddProportionalComponent(NewTextView().SetText("body"), 1)
+	sim := startSimulation(t, root, 30, 10)
+	bar.SetApplication(sim.App)
+	return sim, bar, &activated
+}
+
+func TestMenuBar_Keys(t *testing.T) {
+	sim, bar, activated := startMenuBarSimulation(t)
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	assertGoldenSnapshot(t, goldenPath("menubar_open"), sim.Snapshot())
+
+	// Down skips the disabled item, Right opens the submenu and accelerators activate items.
+	sim.InjectKey(tcell.KeyDown, 0, tcell.ModNone).
+		InjectKey(tcell.KeyDown, 0, tcell.ModNone).
+		InjectKey(tcell.KeyRight, 0, tcell.ModNone).
+		InjectString("b")
+	waitForDraw(t, sim)
+	if bar.IsOpen() || !slices.Equal(*activated, []string{"b.txt"}) {
+		t.Errorf("expected the submenu item to be activated and the menus to close, got %v", *activated)
+	}
+
+	// Left and Right switch between menus when there's no submenu.
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	sim.InjectKey(tcell.KeyRight, 0, tcell.ModNone).InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if bar.IsOpen() || !slices.Equal(*activated, []string{"b.txt", "copy"}) {
+		t.Errorf("expected Right to switch to the next menu, got %v", *activated)
+	}
+
+	// Disabled items can't be activated, and keys sent right after closing don't reach the closed menu.
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	sim.InjectString("pqq")
+	waitForDraw(t, sim)
+	if bar.IsOpen() || !slices.Equal(*activated, []string{"b.txt", "copy", "quit"}) {
+		t.Errorf("expected only one item to be activated, got %v", *activated)
+	}
+}
+
+func TestMenuBar_Mouse(t *testing.T) {
+	sim, bar, activated := startMenuBarSimulation(t)
+	// Clicking the open title closes the menu.
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	if bar.IsOpen() {
+		t.Fatalf("expected clicking the title of the open menu to close it")
+	}
+
+	// Clicking a submenu opens it, and clicking an item in it activates the item.
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	sim.InjectClick(3, 5)
+	waitForDraw(t, sim)
+	if !bar.IsOpen() {
+		t.Fatalf("expected clicking a submenu item to keep the menu open")
+	}
+	sim.InjectClick(22, 7)
+	waitForDraw(t, sim)
+	if bar.IsOpen() || !slices.Equal(*activated, []string{"b.txt"}) {
+		t.Errorf("expected clicking the submenu item to activate it, got %v", *activated)
+	}
+
+	// Clicking outside closes the menu without activating anything.
+	sim.InjectClick(1, 0)
+	waitForDraw(t, sim)
+	sim.InjectClick(25, 9)
+	waitForDraw(t, sim)
+	if bar.IsOpen() || len(*activated) != 1 {
+		t.Errorf("expected clicking outside the menu to close it")
+	}
+}
@@ -0,0 +1,337 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Modal is a centered dialog box with a word-wrapped message and a row of buttons, e.g. for confirmations.
+//
+// The dialog is as tall as the message needs and takes a fraction of the screen width (see SetWidth).
+// It's usually shown in a modal layer:
+//
+//	modal := mauview.NewModal("Leave the room?").
+//		AddButtons("Leave", "Cancel").
+//		SetDoneFunc(func(index int, label string) {
+//			app.RemoveLayer(layer)
+//			...
+//		})
+//	layer = mauview.NewLayer(modal).SetModal(true).SetDimBackground(true)
+//	app.AddLayer(layer)
+//
+// Left, Right, Tab and Backtab move between buttons, Enter or a click presses the focused button, and Escape cancels
+// the dialog. Buttons can also have shortcut runes which press them directly.
+type Modal struct {
+	center  *FractionalCenterer
+	box     *Box
+	content *modalContent
+	done    func(buttonIndex int, buttonLabel string)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+type modalButton struct {
+	button   *Button
+	label    string
+	shortcut rune
+	// The area of the button within the modal content during the last draw.
+	area Rect
+}
+
+type modalContent struct {
+	DirtyTracker
+	modal   *Modal
+	text    string
+	style   tcell.Style
+	buttons []*modalButton
+	focused int
+}
+
+// ModalKeymap is the default keymap of modals. It can be overridden per modal with SetKeymap.
+var ModalKeymap = newDefaultKeymap("modal", []string{
+	"previous-button", "next-button", "press", "cancel",
+}, map[string]string{
+	"Left":    "previous-button",
+	"Backtab": "previous-button",
+	"Right":   "next-button",
+	"Tab":     "next-button",
+	"Enter":   "press",
+	"Esc":     "cancel",
+})
+
+// NewModal returns a new modal dialog with the given message and no buttons.
+func NewModal(text string) *Modal {
+	modal := &Modal{}
+	modal.content = &modalContent{
+		modal: modal,
+		text:  text,
+		style: tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+	}
+	modal.box = NewBox(modal.content).SetBackgroundColor(Styles.PrimitiveBackgroundColor)
+	modal.center = FractionalCenter(modal.box, 30, 0, 0.5, 0)
+	return modal
+}
+
+// SetText sets the message of the modal. It's word-wrapped and color tags are supported.
+func (modal *Modal) SetText(text string) *Modal {
+	modal.content.MarkDirty()
+	modal.content.text = text
+	return modal
+}
+
+// SetTitle sets the title drawn on the border of the modal.
+func (modal *Modal) SetTitle(title string) *Modal {
+	modal.box.SetTitle(title)
+	return modal
+}
+
+// SetTextStyle sets the style of the message and the background of the modal.
+func (modal *Modal) SetTextStyle(style tcell.Style) *Modal {
+	modal.content.MarkDirty()
+	modal.content.style = style
+	_, bg, _ := style.Decompose()
+	modal.box.SetBackgroundColor(bg)
+	return modal
+}
+
+// SetBorderStyle sets the style of the border of the modal.
+func (modal *Modal) SetBorderStyle(style tcell.Style) *Modal {
+	modal.box.SetBorderStyle(style)
+	return modal
+}
+
+// SetWidth sets the width of the modal as a fraction of the screen width, and the minimum width in cells.
+func (modal *Modal) SetWidth(fraction float64, minWidth int) *Modal {
+	modal.center.fractionWidth = fraction
+	modal.center.minWidth = minWidth
+	return modal
+}
+
+// AddButton adds a button to the end of the button row. If shortcut isn't zero, pressing that rune (in either case)
+// presses the button.
+func (modal *Modal) AddButton(label string, shortcut rune) *Modal {
+	modal.content.MarkDirty()
+	modal.content.buttons = append(modal.content.buttons, &modalButton{
+		button:   NewButton(label),
+		label:    label,
+		shortcut: unicode.ToLower(shortcut),
+	})
+	return modal
+}
+
+// AddButtons adds buttons without shortcuts to the end of the button row.
+func (modal *Modal) AddButtons(labels ...string) *Modal {
+	for _, label := range labels {
+		modal.AddButton(label, 0)
+	}
+	return modal
+}
+
+// ClearButtons removes all buttons.
+func (modal *Modal) ClearButtons() *Modal {
+	modal.content.MarkDirty()
+	modal.content.buttons = nil
+	modal.content.focused = 0
+	return modal
+}
+
+// GetButton returns the button at the given index, e.g. to change its style. Returns nil if the index is out of range.
+func (modal *Modal) GetButton(index int) *Button {
+	if index < 0 || index >= len(modal.content.buttons) {
+		return nil
+	}
+	return modal.content.buttons[index].button
+}
+
+// SetFocusedButton moves the focus to the button at the given index.
+func (modal *Modal) SetFocusedButton(index int) *Modal {
+	if index >= 0 && index < len(modal.content.buttons) {
+		modal.content.MarkDirty()
+		modal.content.focused = index
+	}
+	return modal
+}
+
+// GetFocusedButton returns the index of the focused button.
+func (modal *Modal) GetFocusedButton() int {
+	return modal.content.focused
+}
+
+// SetDoneFunc sets a function which is called when a button is pressed or the modal is cancelled.
+// When cancelled with Escape, the index is -1 and the label is empty.
+func (modal *Modal) SetDoneFunc(handler func(buttonIndex int, buttonLabel string)) *Modal {
+	modal.done = handler
+	return modal
+}
+
+// SetKeymap sets the keymap used by this modal. If nil, ModalKeymap is used.
+func (modal *Modal) SetKeymap(keymap *Keymap) *Modal {
+	modal.keymap = keymap
+	modal.keyChord.Reset()
+	return modal
+}
+
+func (modal *Modal) press(index int) {
+	if modal.done == nil {
+		return
+	} else if index < 0 || index >= len(modal.content.buttons) {
+		modal.done(-1, "")
+	} else {
+		modal.done(index, modal.content.buttons[index].label)
+	}
+}
+
+func (modal *Modal) moveFocus(delta int) {
+	count := len(modal.content.buttons)
+	if count > 0 {
+		modal.SetFocusedButton((modal.content.focused + delta + count) % count)
+	}
+}
+
+func (modal *Modal) Focus() {
+	modal.center.Focus()
+}
+
+func (modal *Modal) Blur() {
+	modal.center.Blur()
+}
+
+func (modal *Modal) drawsPartially() {}
+
+func (modal *Modal) Draw(screen Screen) {
+	width, _ := screen.Size()
+	boxWidth := max(int(float64(width)*modal.center.fractionWidth), modal.center.minWidth)
+	boxWidth = min(boxWidth, width)
+	// Two border cells and one cell of padding on each side.
+	modal.center.minHeight = modal.content.height(boxWidth-4) + 2
+	modal.center.Draw(screen)
+}
+
+func (modal *Modal) OnKeyEvent(event KeyEvent) bool {
+	keymap := modal.keymap
+	if keymap == nil {
+		keymap = ModalKeymap
+	}
+	action, consumed := keymap.Process(&modal.keyChord, event)
+	switch action {
+	case "previous-button":
+		modal.moveFocus(-1)
+	case "next-button":
+		modal.moveFocus(1)
+	case "press":
+		if len(modal.content.buttons) > 0 {
+			modal.press(modal.content.focused)
+		}
+	case "cancel":
+		modal.press(-1)
+	case "":
+		if !consumed && event.Key() == tcell.KeyRune {
+			char := unicode.ToLower(event.Rune())
+			for index, btn := range modal.content.buttons {
+				if btn.shortcut != 0 && btn.shortcut == char {
+					modal.SetFocusedButton(index)
+					modal.press(index)
+					return true
+				}
+			}
+		}
+	}
+	// Modals are meant to capture all keyboard input while they're open.
+	return true
+}
+
+func (modal *Modal) OnMouseEvent(event MouseEvent) bool {
+	return modal.center.OnMouseEvent(event)
+}
+
+func (modal *Modal) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
+
+// height returns the number of lines the content needs with the given width.
+func (mc *modalContent) height(width int) int {
+	lines := len(WordWrap(mc.text, max(width, 1)))
+	if len(mc.buttons) > 0 {
+		// An empty line and the button row.
+		lines += 2
+	}
+	return lines
+}
+
+func (mc *modalContent) Focus() {
+	mc.MarkDirty()
+}
+
+func (mc *modalContent) Blur() {
+	mc.MarkDirty()
+}
+
+func (mc *modalContent) Draw(screen Screen) {
+	width, height := screen.Size()
+	screen.SetStyle(mc.style)
+	screen.Clear()
+	textWidth := max(width-2, 1)
+	lines := WordWrap(mc.text, textWidth)
+	for y, line := range lines {
+		PrintWithStyle(screen, line, 1, y, textWidth, AlignCenter, mc.style)
+	}
+	if len(mc.buttons) == 0 {
+		return
+	}
+
+	const buttonPadding, buttonGap = 2, 2
+	totalWidth := -buttonGap
+	for _, btn := range mc.buttons {
+		totalWidth += TaggedStringWidth(btn.label) + buttonPadding*2 + buttonGap
+	}
+	x := max((width-totalWidth)/2, 0)
+	y := min(len(lines)+1, height-1)
+	for index, btn := range mc.buttons {
+		btn.area = Rect{X: x, Y: y, Width: TaggedStringWidth(btn.label) + buttonPadding*2, Height: 1}
+		if index == mc.focused {
+			btn.button.Focus()
+		} else {
+			btn.button.Blur()
+		}
+		btn.button.Draw(&ProxyScreen{
+			Parent:  screen,
+			OffsetX: btn.area.X,
+			OffsetY: btn.area.Y,
+			Width:   btn.area.Width,
+			Height:  btn.area.Height,
+			Style:   tcell.StyleDefault,
+		})
+		x += btn.area.Width + buttonGap
+	}
+}
+
+func (mc *modalContent) OnKeyEvent(event KeyEvent) bool {
+	return false
+}
+
+func (mc *modalContent) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() != tcell.Button1 || event.HasMotion() {
+		return false
+	}
+	for index, btn := range mc.buttons {
+		if btn.area.Contains(event.Position()) {
+			mc.modal.SetFocusedButton(index)
+			mc.modal.press(index)
+			return true
+		}
+	}
+	return false
+}
+
+func (mc *modalContent) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
@@ -0,0 +1,84 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+type modalResult struct {
+	index int
+	label string
+}
+
+func startModalSimulation(t *testing.T, root Component, modal *Modal) (*Simulation, *[]modalResult) {
+	var results []modalResult
+	modal.SetDoneFunc(func(index int, label string) {
+		results = append(results, modalResult{index, label})
+	})
+	app := NewApplication()
+	app.SetRoot(root)
+	app.AddLayer(NewLayer(modal).SetModal(true).SetDimBackground(true))
+	sim, err := StartSimulation(app, 40, 9)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := sim.Stop(); err != nil {
+			t.Errorf("simulation stopped with error: %v", err)
+		}
+	})
+	return sim, &results
+}
+
+func TestModal_Keys(t *testing.T) {
+	field := NewInputField()
+	modal := NewModal("Leave the room?").AddButton("Leave", 'l').AddButtons("Stay", "Cancel")
+	sim, results := startModalSimulation(t, field, modal)
+
+	sim.InjectKey(tcell.KeyRight, 0, tcell.ModNone).
+		InjectKey(tcell.KeyEnter, 0, tcell.ModNone).
+		InjectKey(tcell.KeyTab, 0, tcell.ModNone).
+		InjectKey(tcell.KeyTab, 0, tcell.ModNone).
+		InjectKey(tcell.KeyEnter, 0, tcell.ModNone).
+		InjectKey(tcell.KeyEscape, 0, tcell.ModNone).
+		InjectString("xL")
+	waitForDraw(t, sim)
+	expected := []modalResult{{1, "Stay"}, {0, "Leave"}, {-1, ""}, {0, "Leave"}}
+	if !slices.Equal(*results, expected) {
+		t.Errorf("expected results %v, got %v", expected, *results)
+	}
+	if text := field.GetText(); text != "" {
+		t.Errorf("expected the modal to capture all keys, but %q was typed into the background", text)
+	}
+}
+
+func TestModal_Click(t *testing.T) {
+	modal := NewModal("Leave the room?").AddButtons("Leave", "Cancel")
+	sim, results := startModalSimulation(t, NewTextView(), modal)
+	for y, line := range sim.Lines() {
+		if x := strings.Index(line, "Cancel"); x >= 0 {
+			sim.InjectClick(x, y)
+			break
+		}
+	}
+	waitForDraw(t, sim)
+	if !slices.Equal(*results, []modalResult{{1, "Cancel"}}) || modal.GetFocusedButton() != 1 {
+		t.Errorf("expected clicking a button to focus and press it, got %v", *results)
+	}
+}
+
+func TestGolden_Modal(t *testing.T) {
+	modal := NewModal("Do you really want to leave this room?").SetTitle("Confirm").AddButtons("Leave", "Cancel")
+	sim, _ := startModalSimulation(t, NewTextView().SetText("background"), modal)
+	assertGoldenSnapshot(t, goldenPath("modal"), sim.Snapshot())
+}
@@ -0,0 +1,379 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+type page struct {
+	genericChild
+	name    string
+	visible bool
+	// The focus path inside the page when it was last moved away from the front, used to restore the focus.
+	focusPath []Component
+}
+
+// Pages is a container of named pages, i.e. components that fill the whole area of the container.
+//
+// Any number of pages can be visible at once. Visible pages are drawn on top of each other in order, and the last
+// one is the front page. Only the front page has focus and receives key, paste and mouse events, so e.g. a dialog
+// can be shown on top of the current screen with ShowPage and hidden again with HidePage.
+//
+// When a page stops being the front page, its focus state is remembered and restored when it comes back,
+// so switching between screens doesn't lose e.g. which input field was focused.
+type Pages struct {
+	pages []*page
+	front *page
+
+	focused     bool
+	prevWidth   int
+	prevHeight  int
+	forceRedraw bool
+
+	changed func(name string, comp Component)
+}
+
+// NewPages returns a new, empty pages container.
+func NewPages() *Pages {
+	return &Pages{}
+}
+
+// SetChangedFunc sets a function which is called whenever the front page changes, e.g. when a page is shown, hidden,
+// switched to or removed. The function receives the name and component of the new front page,
+// or an empty string and nil if no page is visible.
+func (pages *Pages) SetChangedFunc(handler func(name string, comp Component)) *Pages {
+	pages.changed = handler
+	return pages
+}
+
+// AddPage adds a page with the given name on top of the existing pages. If a page with the same name already exists,
+// it's replaced. If visible is true, the new page becomes the front page.
+func (pages *Pages) AddPage(name string, comp Component, visible bool) *Pages {
+	pages.removePage(name)
+	pages.pages = append(pages.pages, &page{
+		genericChild: genericChild{
+			target: comp,
+			screen: &ProxyScreen{Style: tcell.StyleDefault},
+		},
+		name:    name,
+		visible: visible,
+	})
+	pages.update()
+	return pages
+}
+
+// AddAndSwitchToPage adds a page with the given name and hides all other pages.
+func (pages *Pages) AddAndSwitchToPage(name string, comp Component) *Pages {
+	pages.AddPage(name, comp, true)
+	return pages.SwitchToPage(name)
+}
+
+// RemovePage removes the page with the given name. If it was the front page, the next visible page below it
+// becomes the front page.
+func (pages *Pages) RemovePage(name string) *Pages {
+	if pages.removePage(name) {
+		pages.update()
+	}
+	return pages
+}
+
+func (pages *Pages) removePage(name string) bool {
+	for index, pg := range pages.pages {
+		if pg.name == name {
+			// If this was the front page, update blurs it and finds the new front page.
+			pages.pages = append(pages.pages[:index], pages.pages[index+1:]...)
+			pages.forceRedraw = true
+			return true
+		}
+	}
+	return false
+}
+
+// HasPage returns true if a page with the given name exists, regardless of whether it's visible.
+func (pages *Pages) HasPage(name string) bool {
+	return pages.find(name) != nil
+}
+
+// GetPage returns the component of the page with the given name, or nil if there's no such page.
+func (pages *Pages) GetPage(name string) Component {
+	if pg := pages.find(name); pg != nil {
+		return pg.target
+	}
+	return nil
+}
+
+// GetPageNames returns the names of the pages from back to front. If visibleOnly is true, hidden pages are skipped.
+func (pages *Pages) GetPageNames(visibleOnly bool) []string {
+	names := make([]string, 0, len(pages.pages))
+	for _, pg := range pages.pages {
+		if pg.visible || !visibleOnly {
+			names = append(names, pg.name)
+		}
+	}
+	return names
+}
+
+// GetPageCount returns the number of pages, including hidden ones.
+func (pages *Pages) GetPageCount() int {
+	return len(pages.pages)
+}
+
+// GetFrontPage returns the name and component of the front page, or an empty string and nil if no page is visible.
+func (pages *Pages) GetFrontPage() (string, Component) {
+	if pages.front == nil {
+		return "", nil
+	}
+	return pages.front.name, pages.front.target
+}
+
+// SwitchToPage shows the page with the given name and hides all other pages.
+func (pages *Pages) SwitchToPage(name string) *Pages {
+	if pages.find(name) == nil {
+		return pages
+	}
+	for _, pg := range pages.pages {
+		pg.visible = pg.name == name
+	}
+	pages.update()
+	return pages
+}
+
+// ShowPage shows the page with the given name on top of the other visible pages, making it the front page.
+func (pages *Pages) ShowPage(name string) *Pages {
+	if pg := pages.find(name); pg != nil {
+		pg.visible = true
+		pages.SendToFront(name)
+	}
+	return pages
+}
+
+// HidePage hides the page with the given name without removing it.
+func (pages *Pages) HidePage(name string) *Pages {
+	if pg := pages.find(name); pg != nil && pg.visible {
+		pg.visible = false
+		pages.update()
+	}
+	return pages
+}
+
+// SendToFront moves the page with the given name to the top of the stack. If it's visible, it becomes the front page.
+func (pages *Pages) SendToFront(name string) *Pages {
+	for index, pg := range pages.pages {
+		if pg.name == name {
+			pages.pages = append(append(pages.pages[:index], pages.pages[index+1:]...), pg)
+			pages.update()
+			break
+		}
+	}
+	return pages
+}
+
+// SendToBack moves the page with the given name to the bottom of the stack.
+func (pages *Pages) SendToBack(name string) *Pages {
+	for index, pg := range pages.pages {
+		if pg.name == name {
+			pages.pages = append([]*page{pg}, append(pages.pages[:index], pages.pages[index+1:]...)...)
+			pages.update()
+			break
+		}
+	}
+	return pages
+}
+
+func (pages *Pages) find(name string) *page {
+	for _, pg := range pages.pages {
+		if pg.name == name {
+			return pg
+		}
+	}
+	return nil
+}
+
+func (pages *Pages) findChild(comp Component) *page {
+	for _, pg := range pages.pages {
+		if pg.target == comp {
+			return pg
+		}
+	}
+	return nil
+}
+
+// update recalculates the front page after the pages have changed, moving the focus and calling the changed
+// function if the front page is different from before.
+func (pages *Pages) update() {
+	pages.forceRedraw = true
+	var front *page
+	for index := len(pages.pages) - 1; index >= 0; index-- {
+		if pages.pages[index].visible {
+			front = pages.pages[index]
+			break
+		}
+	}
+	if front == pages.front {
+		return
+	}
+	if pages.focused && pages.front != nil {
+		pages.front.saveFocus()
+		pages.front.Blur()
+	}
+	pages.front = front
+	if pages.focused && front != nil {
+		front.restoreFocus()
+	}
+	if pages.changed != nil {
+		pages.changed(pages.GetFrontPage())
+	}
+}
+
+// saveFocus remembers the path of focused components inside the page.
+func (pg *page) saveFocus() {
+	pg.focusPath = nil
+	container, ok := pg.target.(focusContainer)
+	for ok {
+		child := container.focusedChild()
+		if child == nil {
+			break
+		}
+		pg.focusPath = append(pg.focusPath, child)
+		container, ok = child.(focusContainer)
+	}
+}
+
+// restoreFocus focuses the page and then the components in the saved focus path, stopping if the path is stale.
+func (pg *page) restoreFocus() {
+	pg.Focus()
+	parent := pg.target
+	for _, child := range pg.focusPath {
+		container, ok := parent.(focusContainer)
+		if !ok || !container.focusChild(child) {
+			break
+		}
+		parent = child
+	}
+	pg.focusPath = nil
+}
+
+func (pages *Pages) drawsPartially() {}
+
+func (pages *Pages) Draw(screen Screen) {
+	width, height := screen.Size()
+	visible := 0
+	for _, pg := range pages.pages {
+		if pg.visible {
+			visible++
+		}
+	}
+	// Stacked pages may overlap, so only a single visible page can be redrawn partially.
+	partial := isPartialDraw(screen) && !pages.forceRedraw && visible <= 1 &&
+		pages.prevWidth == width && pages.prevHeight == height
+	pages.prevWidth, pages.prevHeight = width, height
+	pages.forceRedraw = false
+	if !partial {
+		screen.Clear()
+	}
+	clearStyle := proxyStyle(screen)
+	for _, pg := range pages.pages {
+		if !pg.visible {
+			continue
+		}
+		pg.screen.Parent = screen
+		pg.screen.OffsetX, pg.screen.OffsetY = 0, 0
+		pg.screen.Width, pg.screen.Height = width, height
+		drawChild(pg.target, pg.screen, partial, clearStyle)
+	}
+}
+
+func (pages *Pages) OnKeyEvent(event KeyEvent) bool {
+	if pages.front != nil {
+		return pages.front.target.OnKeyEvent(event)
+	}
+	return false
+}
+
+func (pages *Pages) OnPasteEvent(event PasteEvent) bool {
+	if pages.front != nil {
+		return pages.front.target.OnPasteEvent(event)
+	}
+	return false
+}
+
+func (pages *Pages) OnMouseEvent(event MouseEvent) bool {
+	if pages.front != nil {
+		return pages.front.target.OnMouseEvent(event)
+	}
+	return false
+}
+
+func (pages *Pages) Focus() {
+	if pages.focused {
+		return
+	}
+	pages.focused = true
+	if pages.front != nil {
+		pages.front.restoreFocus()
+	}
+}
+
+func (pages *Pages) Blur() {
+	if !pages.focused {
+		return
+	}
+	pages.focused = false
+	if pages.front != nil {
+		pages.front.saveFocus()
+		pages.front.Blur()
+	}
+}
+
+// Children returns all pages, including hidden ones, from the bottom of the stack to the top.
+func (pages *Pages) Children() []Component {
+	comps := make([]Component, len(pages.pages))
+	for i, pg := range pages.pages {
+		comps[i] = pg.target
+	}
+	return comps
+}
+
+// focusableChildren returns the front page, which is the only page that can have focus or receive events.
+func (pages *Pages) focusableChildren() []Component {
+	if pages.front == nil {
+		return nil
+	}
+	return []Component{pages.front.target}
+}
+
+func (pages *Pages) focusedChild() Component {
+	if pages.focused && pages.front != nil {
+		return pages.front.target
+	}
+	return nil
+}
+
+func (pages *Pages) focusChild(comp Component) bool {
+	if pages.front == nil || pages.front.target != comp {
+		return false
+	}
+	if !pages.focused {
+		pages.focused = true
+		pages.front.Focus()
+	}
+	return true
+}
+
+// ChildArea returns the area of the given page relative to the container as of the last draw.
+// Hidden pages have an empty area.
+func (pages *Pages) ChildArea(comp Component) (Rect, bool) {
+	pg := pages.findChild(comp)
+	if pg == nil {
+		return Rect{}, false
+	} else if !pg.visible {
+		return Rect{}, true
+	}
+	return pg.screen.area(), true
+}
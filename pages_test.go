@@ -0,0 +1,134 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestPages_ChildrenIncludeHiddenPages(t *testing.T) {
+	first, second, third := NewTextView(), NewTextView(), NewTextView()
+	pages := NewPages().
+		AddPage("first", first, true).
+		AddPage("second", second, false).
+		AddPage("third", third, true)
+	RenderSnapshot(pages, 10, 2)
+
+	if children := pages.Children(); !slices.Equal(children, []Component{first, second, third}) {
+		t.Errorf("expected all pages to be children, got %v", children)
+	}
+	var walked []Component
+	Walk(pages, 10, 2, func(comp Component, parent Container, area Rect) bool {
+		walked = append(walked, comp)
+		return true
+	})
+	if len(walked) != 4 {
+		t.Errorf("expected Walk to visit the container and all three pages, got %d components", len(walked))
+	}
+	if path := ComponentsAt(pages, 10, 2, 1, 1); !slices.Equal(path, []Component{pages, third}) {
+		t.Errorf("expected the front page to be found at a point, got %v", path)
+	}
+	if area, ok := pages.ChildArea(second); !ok || !area.IsEmpty() {
+		t.Errorf("expected hidden page to have an empty area, got %v %t", area, ok)
+	}
+}
+
+func TestPages_FocusOnlyFrontPage(t *testing.T) {
+	a1, a2, b1 := NewInputField(), NewInputField(), NewInputField()
+	pageA := NewFlex().SetDirection(FlexRow).AddFixedComponent(a1, 1).AddFixedComponent(a2, 1)
+	pageB := NewFlex().SetDirection(FlexRow).AddFixedComponent(b1, 1)
+	var changes []string
+	pages := NewPages().SetChangedFunc(func(name string, comp Component) {
+		changes = append(changes, name)
+	})
+	pages.AddPage("a", pageA, true).AddPage("b", pageB, false)
+	app := NewApplication()
+	app.SetRoot(pages)
+	sim, err := StartSimulation(app, 20, 4)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	defer sim.Stop()
+	fm := app.FocusManager()
+
+	// Tab cycles through the fields of the front page only.
+	for _, expected := range []Component{a1, a2, a1} {
+		sim.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+		waitForDraw(t, sim)
+		if fm.Focused() != expected {
+			t.Fatalf("expected Tab to only move focus within the front page")
+		}
+	}
+	if fm.SetFocus(b1) {
+		t.Errorf("expected focusing a component on a hidden page to fail")
+	}
+	sim.InjectKey(tcell.KeyTab, 0, tcell.ModNone).InjectString("x")
+	waitForDraw(t, sim)
+
+	pages.SwitchToPage("b")
+	if fm.Focused() != pageB {
+		t.Errorf("expected the new front page to be focused")
+	}
+	pages.SwitchToPage("a")
+	if fm.Focused() != a2 || a2.GetText() != "x" {
+		t.Errorf("expected the focus within the page to be restored")
+	}
+	if !slices.Equal(changes, []string{"a", "b", "a"}) {
+		t.Errorf("unexpected page change callbacks %v", changes)
+	}
+}
+
+func TestPages_Stack(t *testing.T) {
+	var changes []string
+	pages := NewPages().SetChangedFunc(func(name string, comp Component) {
+		changes = append(changes, name)
+	})
+	pages.AddPage("a", NewTextView(), true).
+		AddPage("b", NewTextView(), false).
+		AddPage("c", NewTextView(), true)
+	pages.HidePage("c").ShowPage("b").SendToBack("b").SendToFront("b")
+	if names := pages.GetPageNames(false); !slices.Equal(names, []string{"a", "c", "b"}) {
+		t.Errorf("unexpected page order %v", names)
+	}
+	if names := pages.GetPageNames(true); !slices.Equal(names, []string{"a", "b"}) {
+		t.Errorf("unexpected visible pages %v", names)
+	}
+	// Replacing a page puts it on top.
+	replacement := NewTextView()
+	pages.AddPage("a", replacement, true)
+	if pages.GetPageCount() != 3 || pages.GetPage("a") != replacement {
+		t.Errorf("expected AddPage to replace the existing page with the same name")
+	}
+	pages.RemovePage("a").RemovePage("b").RemovePage("missing")
+	if name, comp := pages.GetFrontPage(); name != "" || comp != nil || pages.HasPage("b") {
+		t.Errorf("expected no front page after removing all visible pages, got %q", name)
+	}
+	expected := []string{"a", "c", "a", "b", "a", "b", "a", "b", ""}
+	if !slices.Equal(changes, expected) {
+		t.Errorf("expected front page changes %q, got %q", expected, changes)
+	}
+}
+
+func TestPages_DrawsVisiblePagesInOrder(t *testing.T) {
+	background := NewTextView().SetText("background text")
+	pages := NewPages().
+		AddPage("background", background, true).
+		AddPage("dialog", NewModal("hi").SetWidth(0, 6), true).
+		AddPage("hidden", NewTextView().SetText("hidden"), false)
+	lines := strings.Split(RenderSnapshot(pages, 16, 3), "\n")
+	if lines[1] != "|backg┌────┐text |" || lines[2] != "|     │ hi │     |" {
+		t.Errorf("expected the dialog to be drawn on top of the background page and the hidden page to be skipped, got %q", lines[1:4])
+	}
+	if path := ComponentsAt(pages, 16, 3, 0, 0); !slices.Equal(path, []Component{pages, pages.GetPage("dialog")}) {
+		t.Errorf("expected only the front page to receive events, got %v", path)
+	}
+}
@@ -0,0 +1,229 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// RadioGroup is a form item that shows a list of options, one per line, of which exactly one can be selected.
+//
+// The arrow keys move the cursor between options, and Space, Enter or a click selects the option under it.
+// Inside a Form, Enter selects the option under the cursor and moves the focus to the next item.
+type RadioGroup struct {
+	DirtyTracker
+
+	options  []string
+	selected int
+	cursor   int
+	offset   int
+	focused  bool
+
+	style           tcell.Style
+	cursorStyle     tcell.Style
+	selectedGlyph   string
+	unselectedGlyph string
+
+	changed func(index int, option string)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// RadioGroupKeymap is the default keymap of radio groups. It can be overridden per radio group with SetKeymap.
+var RadioGroupKeymap = newDefaultKeymap("radio-group", []string{
+	"previous-option", "next-option", "select-option",
+}, map[string]string{
+	"Up":    "previous-option",
+	"Left":  "previous-option",
+	"Down":  "next-option",
+	"Right": "next-option",
+	"Space": "select-option",
+	"Enter": "select-option",
+})
+
+// NewRadioGroup returns a new radio group with the given options. The first option is selected by default.
+func NewRadioGroup(options ...string) *RadioGroup {
+	return &RadioGroup{
+		options:     options,
+		style:       tcell.StyleDefault.Background(Styles.PrimitiveBackgroundColor).Foreground(Styles.PrimaryTextColor),
+		cursorStyle: tcell.StyleDefault.Background(Styles.ContrastBackgroundColor).Foreground(Styles.PrimaryTextColor),
+	}
+}
+
+// SetOptions replaces the options of the radio group.
+// The selection is kept if it's still in range, otherwise the last option is selected.
+func (rg *RadioGroup) SetOptions(options ...string) *RadioGroup {
+	rg.MarkDirty()
+	rg.options = options
+	rg.selected = max(0, min(rg.selected, len(options)-1))
+	rg.cursor = max(0, min(rg.cursor, len(options)-1))
+	return rg
+}
+
+// AddOption adds an option to the end of the radio group.
+func (rg *RadioGroup) AddOption(option string) *RadioGroup {
+	rg.MarkDirty()
+	rg.options = append(rg.options, option)
+	return rg
+}
+
+func (rg *RadioGroup) GetOptions() []string {
+	return rg.options
+}
+
+// SetSelected selects the option at the given index without calling the changed function.
+func (rg *RadioGroup) SetSelected(index int) *RadioGroup {
+	if index < 0 || index >= len(rg.options) {
+		return rg
+	}
+	rg.MarkDirty()
+	rg.selected = index
+	rg.cursor = index
+	return rg
+}
+
+// GetSelected returns the index and text of the selected option, or -1 and an empty string if there are no options.
+func (rg *RadioGroup) GetSelected() (int, string) {
+	if len(rg.options) == 0 {
+		return -1, ""
+	}
+	return rg.selected, rg.options[rg.selected]
+}
+
+// Select selects the option at the given index and calls the changed function if the selection changed.
+func (rg *RadioGroup) Select(index int) *RadioGroup {
+	if index < 0 || index >= len(rg.options) {
+		return rg
+	}
+	rg.MarkDirty()
+	rg.cursor = index
+	if index == rg.selected {
+		return rg
+	}
+	rg.selected = index
+	if rg.changed != nil {
+		rg.changed(index, rg.options[index])
+	}
+	return rg
+}
+
+// SetGlyphs sets the glyphs drawn for the selected and unselected options. Empty strings use the defaults in Glyphs.
+func (rg *RadioGroup) SetGlyphs(selected, unselected string) *RadioGroup {
+	rg.MarkDirty()
+	rg.selectedGlyph = selected
+	rg.unselectedGlyph = unselected
+	return rg
+}
+
+func (rg *RadioGroup) SetStyle(style tcell.Style) *RadioGroup {
+	rg.MarkDirty()
+	rg.style = style
+	return rg
+}
+
+// SetCursorStyle sets the style of the option under the cursor while the radio group is focused.
+func (rg *RadioGroup) SetCursorStyle(style tcell.Style) *RadioGroup {
+	rg.MarkDirty()
+	rg.cursorStyle = style
+	return rg
+}
+
+// SetChangedFunc sets a function which is called when the user selects a different option.
+func (rg *RadioGroup) SetChangedFunc(handler func(index int, option string)) *RadioGroup {
+	rg.changed = handler
+	return rg
+}
+
+// SetKeymap sets the keymap used by this radio group. If nil, RadioGroupKeymap is used.
+func (rg *RadioGroup) SetKeymap(keymap *Keymap) *RadioGroup {
+	rg.keymap = keymap
+	rg.keyChord.Reset()
+	return rg
+}
+
+func (rg *RadioGroup) Focus() {
+	rg.MarkDirty()
+	rg.focused = true
+}
+
+func (rg *RadioGroup) Blur() {
+	rg.MarkDirty()
+	rg.focused = false
+}
+
+func (rg *RadioGroup) Draw(screen Screen) {
+	width, height := screen.Size()
+	screen.SetStyle(rg.style)
+	screen.Clear()
+	if rg.cursor < rg.offset {
+		rg.offset = rg.cursor
+	} else if rg.cursor >= rg.offset+height {
+		rg.offset = rg.cursor - height + 1
+	}
+	rg.offset = max(0, min(rg.offset, len(rg.options)-height))
+	for y := 0; y < height && rg.offset+y < len(rg.options); y++ {
+		index := rg.offset + y
+		glyph := firstNonEmpty(rg.unselectedGlyph, Glyphs.RadioUnselected)
+		if index == rg.selected {
+			glyph = firstNonEmpty(rg.selectedGlyph, Glyphs.RadioSelected)
+		}
+		style := rg.style
+		if rg.focused && index == rg.cursor {
+			style = rg.cursorStyle
+			for x := 0; x < width; x++ {
+				screen.SetContent(x, y, ' ', nil, style)
+			}
+		}
+		printGlyphLabel(screen, glyph, rg.options[index], y, width, style)
+	}
+}
+
+// Submit selects the option under the cursor. It's called by Form when Enter is pressed.
+func (rg *RadioGroup) Submit(event KeyEvent) bool {
+	rg.Select(rg.cursor)
+	return true
+}
+
+func (rg *RadioGroup) OnKeyEvent(event KeyEvent) bool {
+	keymap := rg.keymap
+	if keymap == nil {
+		keymap = RadioGroupKeymap
+	}
+	action, consumed := keymap.Process(&rg.keyChord, event)
+	switch action {
+	case "previous-option":
+		if rg.cursor > 0 {
+			rg.MarkDirty()
+			rg.cursor--
+		}
+	case "next-option":
+		if rg.cursor < len(rg.options)-1 {
+			rg.MarkDirty()
+			rg.cursor++
+		}
+	case "select-option":
+		rg.Select(rg.cursor)
+	}
+	return consumed
+}
+
+func (rg *RadioGroup) OnMouseEvent(event MouseEvent) bool {
+	if event.Buttons() == tcell.Button1 && !event.HasMotion() {
+		_, y := event.Position()
+		if index := rg.offset + y; y >= 0 && index < len(rg.options) {
+			rg.Select(index)
+			return true
+		}
+	}
+	return false
+}
+
+func (rg *RadioGroup) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
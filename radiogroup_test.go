@@ -0,0 +1,80 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestRadioGroup_Select(t *testing.T) {
+	radio := NewRadioGroup("one", "two", "three")
+	var changes []string
+	radio.SetChangedFunc(func(index int, option string) {
+		changes = append(changes, option)
+	})
+	if index, option := radio.GetSelected(); index != 0 || option != "one" {
+		t.Errorf("expected the first option to be selected by default, got %d %q", index, option)
+	}
+	key := func(key tcell.Key, r rune) {
+		radio.OnKeyEvent(tcell.NewEventKey(key, r, tcell.ModNone))
+	}
+	// Moving the cursor doesn't change the selection until the option is selected.
+	key(tcell.KeyDown, 0)
+	key(tcell.KeyDown, 0)
+	key(tcell.KeyDown, 0)
+	if len(changes) != 0 {
+		t.Errorf("expected moving the cursor not to select options, got %v", changes)
+	}
+	key(tcell.KeyRune, ' ')
+	key(tcell.KeyUp, 0)
+	key(tcell.KeyEnter, 0)
+	key(tcell.KeyEnter, 0)
+	if !slices.Equal(changes, []string{"three", "two"}) {
+		t.Errorf("expected selecting options to call the changed function once per change, got %v", changes)
+	}
+
+	RenderSnapshot(radio, 10, 3)
+	radio.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(1, 0, tcell.Button1, tcell.ModNone), false})
+	if index, _ := radio.GetSelected(); index != 0 {
+		t.Errorf("expected clicking an option to select it, got %d", index)
+	}
+	if radio.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(1, 5, tcell.Button1, tcell.ModNone), false}) {
+		t.Errorf("expected clicking below the options not to be handled")
+	}
+
+	radio.SetSelected(2).SetOptions("a", "b")
+	if index, option := radio.GetSelected(); index != 1 || option != "b" || len(changes) != 3 {
+		t.Errorf("expected out of range selections to move to the last option without calling the changed function, got %d %q", index, option)
+	}
+	if index, _ := NewRadioGroup().GetSelected(); index != -1 {
+		t.Errorf("expected a radio group without options not to have a selection")
+	}
+}
+
+func TestRadioGroup_ScrollsToCursor(t *testing.T) {
+	radio := NewRadioGroup("one", "two", "three", "four")
+	radio.Focus()
+	for i := 0; i < 3; i++ {
+		radio.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	}
+	RenderSnapshot(radio, 10, 2)
+	radio.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(1, 0, tcell.Button1, tcell.ModNone), false})
+	if _, option := radio.GetSelected(); option != "three" {
+		t.Errorf("expected the first visible row to be the third option after scrolling, got %q", option)
+	}
+}
+
+func TestGolden_RadioGroup(t *testing.T) {
+	radio := NewRadioGroup("one", "[red]two[-]", "three").SetSelected(1)
+	radio.Focus()
+	radio.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	assertGolden(t, goldenPath("radiogroup"), radio, 10, 3)
+}
@@ -35,6 +35,9 @@ type ProxyScreen struct {
 	OffsetX, OffsetY int
 	Width, Height    int
 	Style            tcell.Style
+
+	// Whether the area still contains the previous frame, see drawChild.
+	partial bool
 }
 
 func NewProxyScreen(parent Screen, offsetX, offsetY, width, height int) Screen {
@@ -53,6 +56,25 @@ func (ss *ProxyScreen) IsInArea(x, y int) bool {
 		y >= ss.OffsetY && y <= ss.OffsetY+ss.Height
 }
 
+func (ss *ProxyScreen) area() Rect {
+	return Rect{X: ss.OffsetX, Y: ss.OffsetY, Width: ss.Width, Height: ss.Height}
+}
+
+// absoluteArea returns the area the given screen covers on the root screen.
+func absoluteArea(screen Screen) Rect {
+	width, height := screen.Size()
+	area := Rect{Width: width, Height: height}
+	for {
+		proxy, ok := screen.(*ProxyScreen)
+		if !ok {
+			return area
+		}
+		area.X += proxy.OffsetX
+		area.Y += proxy.OffsetY
+		screen = proxy.Parent
+	}
+}
+
 func (ss *ProxyScreen) YEnd() int {
 	return ss.OffsetY + ss.Height
 }
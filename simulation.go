@@ -0,0 +1,226 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+var (
+	ErrDrawTimeout       = errors.New("timed out waiting for draw")
+	ErrSimulationStopped = errors.New("simulated application has stopped")
+)
+
+// Simulation runs an Application on a tcell.SimulationScreen, so that components can be tested without a terminal.
+//
+// Events are injected into the screen's event queue, which means they go through the exact same path as real
+// terminal events. Call WaitForDraw after injecting events to wait until they have been handled and drawn.
+type Simulation struct {
+	App    *Application
+	Screen tcell.SimulationScreen
+
+	// The maximum time to wait for a draw before returning ErrDrawTimeout.
+	Timeout time.Duration
+
+	stopped chan struct{}
+	err     error
+}
+
+// NewSimulation creates a new Application with the given root component and starts it on a simulated screen.
+func NewSimulation(root Component, width, height int) (*Simulation, error) {
+	app := NewApplication()
+	app.SetRoot(root)
+	return StartSimulation(app, width, height)
+}
+
+// StartSimulation starts the given application on a simulated screen of the given size.
+// The function returns after the first draw has finished.
+func StartSimulation(app *Application, width, height int) (*Simulation, error) {
+	sim := &Simulation{
+		App:     app,
+		Screen:  tcell.NewSimulationScreen("UTF-8"),
+		Timeout: 5 * time.Second,
+		stopped: make(chan struct{}),
+	}
+	// The screen isn't initialized yet, so the first sync has to go through the update channel.
+	sync := make(drawSync)
+	app.updates <- sync
+	go func() {
+		sim.err = app.StartWithScreen(sim.Screen)
+		close(sim.stopped)
+	}()
+	if err := sim.wait(sync); err != nil {
+		return nil, err
+	}
+	return sim, sim.Resize(width, height)
+}
+
+func (sim *Simulation) wait(sync drawSync) error {
+	select {
+	case <-sync:
+		return nil
+	case <-sim.stopped:
+		if sim.err != nil {
+			return sim.err
+		}
+		return ErrSimulationStopped
+	case <-time.After(sim.Timeout):
+		return ErrDrawTimeout
+	}
+}
+
+// WaitForDraw waits until all previously injected events have been handled and the screen has been redrawn.
+// Updates queued while handling the events, like adding or removing layers, are also waited for.
+func (sim *Simulation) WaitForDraw() error {
+	sync := make(drawSync)
+	if err := sim.Screen.PostEvent(tcell.NewEventInterrupt(sync)); err != nil {
+		return err
+	} else if err = sim.wait(sync); err != nil {
+		return err
+	}
+	// The updates channel is only read by the main loop, so all updates queued by the events are handled first.
+	sync = make(drawSync)
+	select {
+	case sim.App.updates <- sync:
+	case <-sim.stopped:
+		return sim.wait(sync)
+	case <-time.After(sim.Timeout):
+		return ErrDrawTimeout
+	}
+	return sim.wait(sync)
+}
+
+// Stop stops the application and returns the error returned by Application.StartWithScreen, if any.
+func (sim *Simulation) Stop() error {
+	sim.App.Stop()
+	<-sim.stopped
+	return sim.err
+}
+
+// InjectKey injects a key press into the event queue.
+func (sim *Simulation) InjectKey(key tcell.Key, r rune, mod tcell.ModMask) *Simulation {
+	sim.Screen.InjectKey(key, r, mod)
+	return sim
+}
+
+// InjectString injects a key press for each rune in the given string.
+func (sim *Simulation) InjectString(text string) *Simulation {
+	for _, r := range text {
+		if r == '\n' {
+			sim.Screen.InjectKey(tcell.KeyEnter, '\r', tcell.ModNone)
+		} else {
+			sim.Screen.InjectKey(tcell.KeyRune, r, tcell.ModNone)
+		}
+	}
+	return sim
+}
+
+// InjectMouse injects a mouse event into the event queue.
+// Use tcell.ButtonNone to release buttons after a click.
+func (sim *Simulation) InjectMouse(x, y int, buttons tcell.ButtonMask, mod tcell.ModMask) *Simulation {
+	sim.Screen.InjectMouse(x, y, buttons, mod)
+	return sim
+}
+
+// InjectClick injects a left click and release at the given position.
+func (sim *Simulation) InjectClick(x, y int) *Simulation {
+	sim.Screen.InjectMouse(x, y, tcell.Button1, tcell.ModNone)
+	sim.Screen.InjectMouse(x, y, tcell.ButtonNone, tcell.ModNone)
+	return sim
+}
+
+// InjectPaste injects a bracketed paste of the given text into the event queue.
+func (sim *Simulation) InjectPaste(text string) error {
+	if err := sim.Screen.PostEvent(tcell.NewEventPaste(true)); err != nil {
+		return err
+	}
+	sim.InjectString(text)
+	return sim.Screen.PostEvent(tcell.NewEventPaste(false))
+}
+
+// Resize changes the size of the simulated screen and waits for the resulting redraw.
+func (sim *Simulation) Resize(width, height int) error {
+	sim.Screen.SetSize(width, height)
+	if err := sim.Screen.PostEvent(tcell.NewEventResize(width, height)); err != nil {
+		return err
+	}
+	return sim.WaitForDraw()
+}
+
+// Size returns the size of the simulated screen.
+func (sim *Simulation) Size() (int, int) {
+	_, width, height := sim.Screen.GetContents()
+	return width, height
+}
+
+// Cell returns the rendered content of the cell at the given position.
+func (sim *Simulation) Cell(x, y int) (mainc rune, style tcell.Style) {
+	cells, width, height := sim.Screen.GetContents()
+	if x < 0 || y < 0 || x >= width || y >= height {
+		return 0, tcell.StyleDefault
+	}
+	cell := cells[y*width+x]
+	if len(cell.Runes) > 0 {
+		mainc = cell.Runes[0]
+	}
+	return mainc, cell.Style
+}
+
+// Cursor returns the position of the cursor and whether it's visible.
+func (sim *Simulation) Cursor() (x, y int, visible bool) {
+	return sim.Screen.GetCursor()
+}
+
+// Lines returns the rendered text of each row of the screen.
+// Wide characters take up one rune in the returned strings, trailing whitespace is not trimmed.
+func (sim *Simulation) Lines() []string {
+	cells, width, height := sim.Screen.GetContents()
+	lines := make([]string, height)
+	var buf strings.Builder
+	for y := 0; y < height; y++ {
+		buf.Reset()
+		for x := 0; x < width; x++ {
+			cell := cells[y*width+x]
+			if len(cell.Runes) == 0 || cell.Runes[0] == 0 {
+				buf.WriteByte(' ')
+				continue
+			}
+			for _, r := range cell.Runes {
+				buf.WriteRune(r)
+			}
+			if runewidth.RuneWidth(cell.Runes[0]) == 2 {
+				x++
+			}
+		}
+		lines[y] = buf.String()
+	}
+	return lines
+}
+
+// String returns the rendered text of the whole screen with rows separated by newlines.
+func (sim *Simulation) String() string {
+	return strings.Join(sim.Lines(), "\n")
+}
+
+// Styles returns the rendered style of each cell of the screen, indexed by row and then column.
+func (sim *Simulation) Styles() [][]tcell.Style {
+	cells, width, height := sim.Screen.GetContents()
+	styles := make([][]tcell.Style, height)
+	for y := 0; y < height; y++ {
+		styles[y] = make([]tcell.Style, width)
+		for x := 0; x < width; x++ {
+			styles[y][x] = cells[y*width+x].Style
+		}
+	}
+	return styles
+}
@@ -0,0 +1,129 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func startSimulation(t *testing.T, root Component, width, height int) *Simulation {
+	t.Helper()
+	sim, err := NewSimulation(root, width, height)
+	if err != nil {
+		t.Fatalf("failed to start simulation: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := sim.Stop(); err != nil {
+			t.Errorf("simulation stopped with error: %v", err)
+		}
+	})
+	return sim
+}
+
+func waitForDraw(t *testing.T, sim *Simulation) {
+	t.Helper()
+	if err := sim.WaitForDraw(); err != nil {
+		t.Fatalf("failed to wait for draw: %v", err)
+	}
+}
+
+func TestSimulation_InputArea(t *testing.T) {
+	area := NewInputArea()
+	grid := NewGrid().AddComponent(area, 0, 0, 1, 1)
+	sim := startSimulation(t, grid, 20, 3)
+
+	sim.InjectClick(0, 0).InjectString("hello")
+	waitForDraw(t, sim)
+	if line := sim.Lines()[0]; !strings.HasPrefix(line, "hello ") {
+		t.Errorf("expected first line to start with the typed text, got %q", line)
+	}
+	if x, y, visible := sim.Cursor(); x != 5 || y != 0 || !visible {
+		t.Errorf("expected visible cursor at (5, 0), got (%d, %d) visible=%t", x, y, visible)
+	}
+
+	if err := sim.InjectPaste(" world"); err != nil {
+		t.Fatalf("failed to inject paste: %v", err)
+	}
+	sim.InjectKey(tcell.KeyHome, 0, tcell.ModNone).InjectString(">")
+	waitForDraw(t, sim)
+	if text := area.GetText(); text != ">hello world" {
+		t.Errorf("expected %q, got %q", ">hello world", text)
+	}
+
+	if err := sim.Resize(8, 3); err != nil {
+		t.Fatalf("failed to resize: %v", err)
+	}
+	if width, height := sim.Size(); width != 8 || height != 3 {
+		t.Errorf("expected 8x3 screen, got %dx%d", width, height)
+	}
+	if lines := sim.Lines(); lines[0] != ">hello  " || lines[1] != "world   " {
+		t.Errorf("expected text to be wrapped after resize, got %q", lines)
+	}
+}
+
+func TestSimulation_GridFocus(t *testing.T) {
+	left, right := NewInputField(), NewInputField()
+	grid := NewGrid().SetColumns([]int{-1, -1}).
+		AddComponent(left, 0, 0, 1, 1).
+		AddComponent(right, 1, 0, 1, 1)
+	sim := startSimulation(t, grid, 20, 1)
+
+	sim.InjectClick(12, 0).InjectString("b")
+	waitForDraw(t, sim)
+	if grid.GetFocused() != right || !right.focused || left.focused {
+		t.Fatalf("expected click to focus the right field")
+	}
+	sim.InjectClick(2, 0).InjectString("a")
+	waitForDraw(t, sim)
+	if grid.GetFocused() != left || !left.focused || right.focused {
+		t.Fatalf("expected click to focus the left field")
+	}
+	if left.GetText() != "a" || right.GetText() != "b" {
+		t.Errorf("expected typed text to go to the focused field, got %q and %q", left.GetText(), right.GetText())
+	}
+	if line := sim.Lines()[0]; line != "a         b         " {
+		t.Errorf("unexpected screen content %q", line)
+	}
+}
+
+func TestSimulation_FormNavigation(t *testing.T) {
+	first, second, third := NewInputField(), NewInputField(), NewInputField()
+	form := NewForm()
+	form.AddFormItem(first, 0, 0, 1, 1).
+		AddFormItem(second, 0, 1, 1, 1).
+		AddFormItem(third, 0, 2, 1, 1)
+	form.SetRows([]int{1, 1, 1})
+	form.FocusItem(first)
+	sim := startSimulation(t, form, 10, 3)
+
+	sim.InjectString("1").InjectKey(tcell.KeyTab, 0, tcell.ModNone).InjectString("2")
+	// Enter submits the input field, which moves the focus to the next item.
+	sim.InjectKey(tcell.KeyEnter, '\r', tcell.ModNone).InjectString("3")
+	waitForDraw(t, sim)
+	if form.GetFocused() != third {
+		t.Fatalf("expected the third item to be focused")
+	}
+	if lines := sim.Lines(); lines[0] != "1         " || lines[1] != "2         " || lines[2] != "3         " {
+		t.Errorf("unexpected screen content %q", lines)
+	}
+
+	// Tab wraps around to the first item, Backtab wraps back to the last.
+	sim.InjectKey(tcell.KeyTab, 0, tcell.ModNone)
+	waitForDraw(t, sim)
+	if form.GetFocused() != first {
+		t.Errorf("expected Tab on the last item to wrap to the first item")
+	}
+	sim.InjectKey(tcell.KeyBacktab, 0, tcell.ModShift)
+	waitForDraw(t, sim)
+	if form.GetFocused() != third {
+		t.Errorf("expected Backtab on the first item to wrap to the last item")
+	}
+}
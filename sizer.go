@@ -0,0 +1,44 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"math"
+)
+
+// Sizer is implemented by components that can measure how much space they'd like to have,
+// e.g. a text view that wants to be as tall as its text.
+type Sizer interface {
+	// PreferredSize returns the preferred width and height of the component when it has at most the given
+	// width and height available. The result may be larger than the available space if the content doesn't fit,
+	// in which case the container decides how much of it to give.
+	PreferredSize(width, height int) (int, int)
+}
+
+// AutoSize can be used as the size of a Flex child or a Grid row or column to size it based on the preferred
+// size of the components in it (see Sizer). Components that don't implement Sizer are sized proportionally instead,
+// as if the size was -1.
+const AutoSize = math.MinInt32
+
+var (
+	_ Sizer = (*Button)(nil)
+	_ Sizer = (*TextField)(nil)
+	_ Sizer = (*TextView)(nil)
+	_ Sizer = (*InputArea)(nil)
+	_ Sizer = (*Box)(nil)
+)
+
+// preferredSize returns the preferred size of the component, or false if it doesn't implement Sizer.
+func preferredSize(comp Component, width, height int) (int, int, bool) {
+	sizer, ok := comp.(Sizer)
+	if !ok {
+		return 0, 0, false
+	}
+	preferredWidth, preferredHeight := sizer.PreferredSize(width, height)
+	return preferredWidth, preferredHeight, true
+}
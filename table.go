@@ -0,0 +1,825 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// TableCell is a single cell in a Table.
+//
+// The fields of a cell can be changed after it's added to a table, but MarkDirty must be called on the table
+// afterwards to redraw it.
+type TableCell struct {
+	// The text of the cell. Color tags are supported.
+	Text string
+	// The style of the cell. The background of the style is used for the whole cell.
+	Style tcell.Style
+	// One of AlignLeft, AlignCenter or AlignRight.
+	Align int
+	// If true, the cell can't be selected in cell selection mode.
+	NotSelectable bool
+	// Any value the application wants to associate with the cell.
+	Reference any
+}
+
+// NewTableCell returns a new left-aligned table cell with the default style.
+func NewTableCell(text string) *TableCell {
+	return &TableCell{
+		Text:  text,
+		Style: tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+		Align: AlignLeft,
+	}
+}
+
+func (cell *TableCell) SetText(text string) *TableCell {
+	cell.Text = text
+	return cell
+}
+
+func (cell *TableCell) SetStyle(style tcell.Style) *TableCell {
+	cell.Style = style
+	return cell
+}
+
+func (cell *TableCell) SetAlign(align int) *TableCell {
+	cell.Align = align
+	return cell
+}
+
+func (cell *TableCell) SetSelectable(selectable bool) *TableCell {
+	cell.NotSelectable = !selectable
+	return cell
+}
+
+func (cell *TableCell) SetReference(reference any) *TableCell {
+	cell.Reference = reference
+	return cell
+}
+
+// TableSource provides the cells of a Table on demand, so that the table doesn't need to hold every row in memory.
+// The table only requests the rows it draws, plus single rows when checking whether cells can be selected.
+type TableSource interface {
+	// Count returns the number of rows, including fixed rows.
+	Count() int
+	// Row returns the cells of the row at the given index, which is always between 0 and Count()-1.
+	// The slice may be shorter than ColumnCount, and nil cells are drawn as empty.
+	Row(index int) []*TableCell
+	// ColumnCount returns the number of columns, including fixed columns.
+	ColumnCount() int
+}
+
+// Table is a grid of text cells with optional fixed header rows and columns.
+//
+// Column widths work like Grid's: positive widths are fixed, negative widths are proportional and share the space
+// that's left over, and zero (the default) fits the widest visible cell in the column.
+//
+// What can be selected depends on SetSelectable: rows, columns, single cells or nothing, in which case the arrow
+// keys scroll the table instead. Fixed rows and columns are always visible and can't be selected.
+type Table struct {
+	DirtyTracker
+
+	cells        [][]*TableCell
+	columnCount  int
+	source       TableSource
+	columnWidths []int
+	fixedRows    int
+	fixedColumns int
+
+	rowsSelectable    bool
+	columnsSelectable bool
+	selectedRow       int
+	selectedColumn    int
+	// Whether the offsets should be adjusted to make the selection visible during the next draw.
+	scrollToSelection bool
+
+	rowOffset    int
+	columnOffset int
+
+	separator       rune
+	separatorStyle  tcell.Style
+	backgroundColor tcell.Color
+	selectedStyle   tcell.Style
+
+	selectedFocusOnly bool
+	focused           bool
+
+	// The layout of the last draw, used for mouse events and paging.
+	visibleRows    []int
+	visibleCells   [][]*TableCell
+	visibleColumns []tableColumn
+	height         int
+
+	selectionChanged func(row, column int)
+	selected         func(row, column int)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+type tableColumn struct {
+	index int
+	x     int
+	width int
+}
+
+// TableKeymap is the default keymap of tables. It can be overridden per table with SetKeymap.
+var TableKeymap = newDefaultKeymap("table", []string{
+	"move-up", "move-down", "move-left", "move-right", "move-home", "move-end", "page-up", "page-down", "select",
+}, map[string]string{
+	"Up":    "move-up",
+	"Down":  "move-down",
+	"Left":  "move-left",
+	"Right": "move-right",
+	"Home":  "move-home",
+	"End":   "move-end",
+	"PgUp":  "page-up",
+	"PgDn":  "page-down",
+	"Enter": "select",
+})
+
+// NewTable returns a new empty table without any selection.
+func NewTable() *Table {
+	return &Table{
+		separator:       ' ',
+		separatorStyle:  tcell.StyleDefault.Foreground(Styles.GraphicsColor).Background(Styles.PrimitiveBackgroundColor),
+		backgroundColor: Styles.PrimitiveBackgroundColor,
+		selectedStyle:   tcell.StyleDefault.Foreground(Styles.PrimitiveBackgroundColor).Background(Styles.PrimaryTextColor),
+	}
+}
+
+// SetCell sets the cell at the given position, extending the table if necessary. A nil cell leaves the position empty.
+func (table *Table) SetCell(row, column int, cell *TableCell) *Table {
+	if row < 0 || column < 0 {
+		return table
+	}
+	table.MarkDirty()
+	if row >= len(table.cells) {
+		table.cells = append(table.cells, make([][]*TableCell, row-len(table.cells)+1)...)
+	}
+	if column >= len(table.cells[row]) {
+		table.cells[row] = append(table.cells[row], make([]*TableCell, column-len(table.cells[row])+1)...)
+	}
+	table.cells[row][column] = cell
+	table.columnCount = max(table.columnCount, column+1)
+	return table
+}
+
+// SetCellSimple sets the cell at the given position to a new cell with the given text and the default style.
+func (table *Table) SetCellSimple(row, column int, text string) *Table {
+	return table.SetCell(row, column, NewTableCell(text))
+}
+
+// GetCell returns the cell at the given position, or nil if the position is empty.
+func (table *Table) GetCell(row, column int) *TableCell {
+	if row < 0 || row >= table.GetRowCount() || column < 0 {
+		return nil
+	}
+	cells := table.getRow(row)
+	if column >= len(cells) {
+		return nil
+	}
+	return cells[column]
+}
+
+func (table *Table) getRow(row int) []*TableCell {
+	if table.source != nil {
+		return table.source.Row(row)
+	}
+	return table.cells[row]
+}
+
+// GetRowCount returns the number of rows in the table, including fixed rows.
+func (table *Table) GetRowCount() int {
+	if table.source != nil {
+		return table.source.Count()
+	}
+	return len(table.cells)
+}
+
+// GetColumnCount returns the number of columns in the table, including fixed columns.
+func (table *Table) GetColumnCount() int {
+	if table.source != nil {
+		return table.source.ColumnCount()
+	}
+	return table.columnCount
+}
+
+// SetSource makes the table get its cells from the given source instead of the cells set with SetCell.
+// While a source is set, the methods for changing cells and rows only change the table's own cells,
+// which are shown again if the source is set back to nil.
+// If the content of the source changes, MarkDirty must be called to redraw the table.
+func (table *Table) SetSource(source TableSource) *Table {
+	table.MarkDirty()
+	table.source = source
+	table.rowOffset = 0
+	table.columnOffset = 0
+	table.selectedRow = 0
+	table.selectedColumn = 0
+	table.clampSelection()
+	return table
+}
+
+// GetSource returns the source set with SetSource, or nil if the table uses its own cells.
+func (table *Table) GetSource() TableSource {
+	return table.source
+}
+
+// InsertRow inserts an empty row before the given row.
+func (table *Table) InsertRow(row int) *Table {
+	if row < 0 || row > len(table.cells) {
+		return table
+	}
+	table.MarkDirty()
+	table.cells = append(table.cells, nil)
+	copy(table.cells[row+1:], table.cells[row:])
+	table.cells[row] = nil
+	if table.source == nil && row <= table.selectedRow && len(table.cells) > 1 {
+		table.selectedRow++
+	}
+	return table
+}
+
+// RemoveRow removes the given row.
+func (table *Table) RemoveRow(row int) *Table {
+	if row < 0 || row >= len(table.cells) {
+		return table
+	}
+	table.MarkDirty()
+	table.cells = append(table.cells[:row], table.cells[row+1:]...)
+	if table.source == nil && (row < table.selectedRow || table.selectedRow >= len(table.cells)) {
+		table.selectedRow = max(0, table.selectedRow-1)
+	}
+	return table
+}
+
+// InsertColumn inserts an empty column before the given column in every row.
+func (table *Table) InsertColumn(column int) *Table {
+	if column < 0 || column > table.columnCount {
+		return table
+	}
+	table.MarkDirty()
+	for row, cells := range table.cells {
+		if column < len(cells) {
+			cells = append(cells, nil)
+			copy(cells[column+1:], cells[column:])
+			cells[column] = nil
+			table.cells[row] = cells
+		}
+	}
+	if column < len(table.columnWidths) {
+		table.columnWidths = append(table.columnWidths, 0)
+		copy(table.columnWidths[column+1:], table.columnWidths[column:])
+		table.columnWidths[column] = 0
+	}
+	table.columnCount++
+	if table.source == nil && column <= table.selectedColumn && table.columnCount > 1 {
+		table.selectedColumn++
+	}
+	return table
+}
+
+// RemoveColumn removes the given column from every row.
+func (table *Table) RemoveColumn(column int) *Table {
+	if column < 0 || column >= table.columnCount {
+		return table
+	}
+	table.MarkDirty()
+	for row, cells := range table.cells {
+		if column < len(cells) {
+			table.cells[row] = append(cells[:column], cells[column+1:]...)
+		}
+	}
+	if column < len(table.columnWidths) {
+		table.columnWidths = append(table.columnWidths[:column], table.columnWidths[column+1:]...)
+	}
+	table.columnCount--
+	if table.source == nil && (column < table.selectedColumn || table.selectedColumn >= table.columnCount) {
+		table.selectedColumn = max(0, table.selectedColumn-1)
+	}
+	return table
+}
+
+// Clear removes all cells from the table. Column widths and fixed rows and columns are kept.
+func (table *Table) Clear() *Table {
+	table.MarkDirty()
+	table.cells = nil
+	table.columnCount = 0
+	if table.source != nil {
+		return table
+	}
+	table.rowOffset = 0
+	table.columnOffset = 0
+	table.selectedRow = 0
+	table.selectedColumn = 0
+	return table
+}
+
+// SetFixed sets the number of header rows and columns that are always visible regardless of scrolling.
+func (table *Table) SetFixed(rows, columns int) *Table {
+	table.MarkDirty()
+	table.fixedRows = max(rows, 0)
+	table.fixedColumns = max(columns, 0)
+	table.clampSelection()
+	return table
+}
+
+// SetColumnWidth sets the width of a single column. See the Table docs for the meaning of the value.
+func (table *Table) SetColumnWidth(column, width int) *Table {
+	if column < 0 {
+		return table
+	}
+	table.MarkDirty()
+	if column >= len(table.columnWidths) {
+		table.columnWidths = append(table.columnWidths, make([]int, column-len(table.columnWidths)+1)...)
+	}
+	table.columnWidths[column] = width
+	return table
+}
+
+// SetColumnWidths sets the widths of all columns. Columns not included in the slice fit their content.
+func (table *Table) SetColumnWidths(widths []int) *Table {
+	table.MarkDirty()
+	table.columnWidths = widths
+	return table
+}
+
+// SetSeparator sets the rune drawn between columns. Zero means columns are drawn without any space between them.
+func (table *Table) SetSeparator(separator rune) *Table {
+	table.MarkDirty()
+	table.separator = separator
+	return table
+}
+
+func (table *Table) SetSeparatorStyle(style tcell.Style) *Table {
+	table.MarkDirty()
+	table.separatorStyle = style
+	return table
+}
+
+func (table *Table) SetBackgroundColor(color tcell.Color) *Table {
+	table.MarkDirty()
+	table.backgroundColor = color
+	return table
+}
+
+func (table *Table) SetSelectedStyle(style tcell.Style) *Table {
+	table.MarkDirty()
+	table.selectedStyle = style
+	return table
+}
+
+// SetSelectedFocusOnly sets whether the selection is only highlighted when the table is focused.
+func (table *Table) SetSelectedFocusOnly(focusOnly bool) *Table {
+	table.MarkDirty()
+	table.selectedFocusOnly = focusOnly
+	return table
+}
+
+// SetSelectable sets what can be selected in the table:
+//
+//   - rows = false, columns = false: nothing can be selected and the arrow keys scroll the table.
+//   - rows = true, columns = false: whole rows are selected.
+//   - rows = false, columns = true: whole columns are selected.
+//   - rows = true, columns = true: single cells are selected.
+func (table *Table) SetSelectable(rows, columns bool) *Table {
+	table.MarkDirty()
+	table.rowsSelectable = rows
+	table.columnsSelectable = columns
+	table.clampSelection()
+	return table
+}
+
+// clampSelection moves the selection out of the fixed rows and columns without calling the selection changed function.
+func (table *Table) clampSelection() {
+	table.selectedRow = max(table.selectedRow, table.fixedRows)
+	table.selectedColumn = max(table.selectedColumn, table.fixedColumns)
+	table.scrollToSelection = true
+}
+
+// GetSelectable returns what can be selected in the table, see SetSelectable.
+func (table *Table) GetSelectable() (rows, columns bool) {
+	return table.rowsSelectable, table.columnsSelectable
+}
+
+// Select changes the selection to the given position and calls the selection changed function if it changed.
+// In row selection mode the column is ignored and vice versa.
+func (table *Table) Select(row, column int) *Table {
+	if !table.rowsSelectable {
+		row = table.selectedRow
+	}
+	if !table.columnsSelectable {
+		column = table.selectedColumn
+	}
+	if row == table.selectedRow && column == table.selectedColumn {
+		return table
+	}
+	table.MarkDirty()
+	table.selectedRow, table.selectedColumn = row, column
+	table.scrollToSelection = true
+	if table.selectionChanged != nil {
+		table.selectionChanged(row, column)
+	}
+	return table
+}
+
+// GetSelection returns the selected row and column. In row selection mode the column is meaningless and vice versa.
+func (table *Table) GetSelection() (row, column int) {
+	return table.selectedRow, table.selectedColumn
+}
+
+// SetOffset sets the number of non-fixed rows and columns that are scrolled out of view.
+func (table *Table) SetOffset(row, column int) *Table {
+	table.MarkDirty()
+	table.rowOffset = max(row, 0)
+	table.columnOffset = max(column, 0)
+	return table
+}
+
+// GetOffset returns the number of non-fixed rows and columns that are scrolled out of view.
+func (table *Table) GetOffset() (row, column int) {
+	return table.rowOffset, table.columnOffset
+}
+
+// SetSelectionChangedFunc sets a function which is called when the selection changes.
+func (table *Table) SetSelectionChangedFunc(handler func(row, column int)) *Table {
+	table.selectionChanged = handler
+	return table
+}
+
+// SetSelectedFunc sets a function which is called when Enter is pressed on the selection
+// or the selection is clicked.
+func (table *Table) SetSelectedFunc(handler func(row, column int)) *Table {
+	table.selected = handler
+	return table
+}
+
+// SetKeymap sets the keymap used by this table. If nil, TableKeymap is used.
+func (table *Table) SetKeymap(keymap *Keymap) *Table {
+	table.keymap = keymap
+	table.keyChord.Reset()
+	return table
+}
+
+func (table *Table) hasSelection() bool {
+	return table.rowsSelectable || table.columnsSelectable
+}
+
+func (table *Table) isSelected(row, column int) bool {
+	if row < table.fixedRows || column < table.fixedColumns {
+		return false
+	}
+	switch {
+	case table.rowsSelectable && table.columnsSelectable:
+		return row == table.selectedRow && column == table.selectedColumn
+	case table.rowsSelectable:
+		return row == table.selectedRow
+	case table.columnsSelectable:
+		return column == table.selectedColumn
+	}
+	return false
+}
+
+func (table *Table) isSelectable(row, column int) bool {
+	if table.rowsSelectable && (row < table.fixedRows || row >= table.GetRowCount()) {
+		return false
+	} else if table.columnsSelectable && (column < table.fixedColumns || column >= table.GetColumnCount()) {
+		return false
+	} else if table.rowsSelectable && table.columnsSelectable {
+		cell := table.GetCell(row, column)
+		return cell != nil && !cell.NotSelectable
+	}
+	return true
+}
+
+// moveSelection moves the selection along one axis by the given amount, skipping positions that can't be selected.
+// If there's nothing selectable at or past the target, the selection moves as far as it can.
+// Moving along an axis that can't be selected scrolls the table instead.
+func (table *Table) moveSelection(rowDelta, columnDelta int) {
+	if (rowDelta != 0 && !table.rowsSelectable) || (columnDelta != 0 && !table.columnsSelectable) {
+		table.MarkDirty()
+		table.rowOffset += rowDelta
+		table.columnOffset += columnDelta
+		return
+	}
+	rowStep, columnStep := sign(rowDelta), sign(columnDelta)
+	if rowStep == 0 && columnStep == 0 {
+		return
+	}
+	row, column := table.selectedRow+rowDelta, table.selectedColumn+columnDelta
+	for !table.isSelectable(row, column) {
+		row, column = row+rowStep, column+columnStep
+		if row < 0 || column < 0 || row >= table.GetRowCount() || column >= table.GetColumnCount() {
+			// Nothing selectable past the target, so look back towards the current selection instead.
+			row, column = table.selectedRow+rowDelta, table.selectedColumn+columnDelta
+			for (row != table.selectedRow || column != table.selectedColumn) && !table.isSelectable(row, column) {
+				row, column = row-rowStep, column-columnStep
+			}
+			break
+		}
+	}
+	table.Select(row, column)
+}
+
+// selectEdge selects the first or last selectable position in the table.
+func (table *Table) selectEdge(last bool) {
+	rows, columns := table.GetRowCount(), table.GetColumnCount()
+	for i := 0; i < rows*columns; i++ {
+		row, column := i/columns, i%columns
+		if last {
+			row, column = rows-1-row, columns-1-column
+		}
+		if !table.rowsSelectable {
+			row = table.selectedRow
+		}
+		if !table.columnsSelectable {
+			column = table.selectedColumn
+		}
+		if table.isSelectable(row, column) {
+			table.Select(row, column)
+			return
+		}
+	}
+}
+
+func sign(value int) int {
+	if value < 0 {
+		return -1
+	} else if value > 0 {
+		return 1
+	}
+	return 0
+}
+
+func (table *Table) visibleRowCount() int {
+	return max(table.height-table.fixedRows, 1)
+}
+
+func (table *Table) OnKeyEvent(event KeyEvent) bool {
+	keymap := table.keymap
+	if keymap == nil {
+		keymap = TableKeymap
+	}
+	action, consumed := keymap.Process(&table.keyChord, event)
+	if !consumed {
+		return false
+	}
+	page := table.visibleRowCount()
+	switch action {
+	case "move-up":
+		table.moveSelection(-1, 0)
+	case "move-down":
+		table.moveSelection(1, 0)
+	case "move-left":
+		table.moveSelection(0, -1)
+	case "move-right":
+		table.moveSelection(0, 1)
+	case "page-up":
+		table.moveSelection(-page, 0)
+	case "page-down":
+		table.moveSelection(page, 0)
+	case "move-home":
+		if table.hasSelection() {
+			table.selectEdge(false)
+		} else {
+			table.MarkDirty()
+			table.rowOffset, table.columnOffset = 0, 0
+		}
+	case "move-end":
+		if table.hasSelection() {
+			table.selectEdge(true)
+		} else {
+			table.MarkDirty()
+			table.rowOffset = table.GetRowCount()
+		}
+	case "select":
+		if table.selected != nil && table.hasSelection() {
+			table.selected(table.selectedRow, table.selectedColumn)
+		}
+	}
+	return true
+}
+
+func (table *Table) OnMouseEvent(event MouseEvent) bool {
+	switch event.Buttons() {
+	case tcell.Button1:
+		if event.HasMotion() || !table.hasSelection() {
+			return false
+		}
+		row, column, ok := table.cellAt(event.Position())
+		if !ok || !table.isSelectable(row, column) {
+			return false
+		}
+		if table.isSelected(row, column) {
+			if table.selected != nil {
+				table.selected(row, column)
+			}
+		} else {
+			table.Select(row, column)
+		}
+	case tcell.WheelUp:
+		table.MarkDirty()
+		table.rowOffset--
+	case tcell.WheelDown:
+		table.MarkDirty()
+		table.rowOffset++
+	default:
+		return false
+	}
+	return true
+}
+
+// cellAt returns the row and column drawn at the given position during the last draw.
+func (table *Table) cellAt(x, y int) (row, column int, ok bool) {
+	if y < 0 || y >= len(table.visibleRows) {
+		return
+	}
+	for _, col := range table.visibleColumns {
+		if x >= col.x && x < col.x+col.width {
+			return table.visibleRows[y], col.index, true
+		}
+	}
+	return
+}
+
+func (table *Table) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
+
+func (table *Table) Focus() {
+	table.MarkDirty()
+	table.focused = true
+}
+
+func (table *Table) Blur() {
+	table.MarkDirty()
+	table.focused = false
+}
+
+func (table *Table) adjustRowOffset(height, rowCount int) {
+	visibleRows := max(height-table.fixedRows, 1)
+	if table.scrollToSelection && table.rowsSelectable {
+		if table.selectedRow-table.fixedRows < table.rowOffset {
+			table.rowOffset = table.selectedRow - table.fixedRows
+		} else if table.selectedRow-table.fixedRows >= table.rowOffset+visibleRows {
+			table.rowOffset = table.selectedRow - table.fixedRows - visibleRows + 1
+		}
+	}
+	table.rowOffset = max(0, min(table.rowOffset, rowCount-table.fixedRows-visibleRows))
+}
+
+func (table *Table) columnWidthSpec(column int) int {
+	if column < len(table.columnWidths) {
+		return table.columnWidths[column]
+	}
+	return 0
+}
+
+func (table *Table) contentWidth(column int) int {
+	width := 0
+	for _, cells := range table.visibleCells {
+		if column < len(cells) && cells[column] != nil {
+			width = max(width, TaggedStringWidth(cells[column].Text))
+		}
+	}
+	return width
+}
+
+// layoutColumns decides which columns are visible with the current column offset and how wide they are.
+// Returns false if the last visible column is cut off.
+func (table *Table) layoutColumns(width int) bool {
+	separatorWidth := 0
+	if table.separator != 0 {
+		separatorWidth = 1
+	}
+	table.visibleColumns = table.visibleColumns[:0]
+	var specs []int
+	used := 0
+	addColumn := func(column int) bool {
+		if used >= width || (len(table.visibleColumns) > 0 && used+separatorWidth >= width) {
+			return false
+		}
+		spec := table.columnWidthSpec(column)
+		colWidth := spec
+		if spec == 0 {
+			colWidth = table.contentWidth(column)
+		} else if spec < 0 {
+			// Proportional columns get at least one cell, the rest is distributed after all columns are known.
+			colWidth = 1
+		}
+		if len(table.visibleColumns) > 0 {
+			used += separatorWidth
+		}
+		table.visibleColumns = append(table.visibleColumns, tableColumn{index: column, x: used, width: colWidth})
+		specs = append(specs, spec)
+		used += colWidth
+		return true
+	}
+	for column := 0; column < min(table.fixedColumns, table.GetColumnCount()); column++ {
+		addColumn(column)
+	}
+	for column := table.fixedColumns + table.columnOffset; column < table.GetColumnCount(); column++ {
+		if !addColumn(column) {
+			break
+		}
+	}
+
+	if leftover := width - used; leftover > 0 {
+		_, dynamicColumns := pnSum(specs)
+		extra := fillDynamic(specs, leftover, dynamicColumns)
+		offset := 0
+		for i := range table.visibleColumns {
+			table.visibleColumns[i].x += offset
+			if specs[i] < 0 {
+				table.visibleColumns[i].width += extra[i]
+				offset += extra[i]
+			}
+		}
+		return true
+	}
+	return used <= width
+}
+
+func (table *Table) adjustColumnOffset(width int) {
+	maxOffset := max(table.GetColumnCount()-table.fixedColumns-1, 0)
+	table.columnOffset = max(0, min(table.columnOffset, maxOffset))
+	if table.scrollToSelection && table.columnsSelectable {
+		if table.selectedColumn-table.fixedColumns < table.columnOffset {
+			table.columnOffset = max(table.selectedColumn-table.fixedColumns, 0)
+		}
+	}
+	for {
+		fits := table.layoutColumns(width)
+		if len(table.visibleColumns) == 0 {
+			// Nothing fits on the screen, so there's nothing to scroll towards.
+			return
+		} else if !table.scrollToSelection || !table.columnsSelectable || table.columnOffset >= maxOffset {
+			break
+		}
+		last := table.visibleColumns[len(table.visibleColumns)-1]
+		if last.index > table.selectedColumn || (last.index == table.selectedColumn && fits) {
+			break
+		}
+		table.columnOffset++
+	}
+}
+
+func (table *Table) Draw(screen Screen) {
+	width, height := screen.Size()
+	table.height = height
+	screen.SetStyle(tcell.StyleDefault.Background(table.backgroundColor))
+	screen.Clear()
+	rowCount := table.GetRowCount()
+	table.adjustRowOffset(height, rowCount)
+	table.visibleRows = table.visibleRows[:0]
+	table.visibleCells = table.visibleCells[:0]
+	addRow := func(row int) {
+		table.visibleRows = append(table.visibleRows, row)
+		table.visibleCells = append(table.visibleCells, table.getRow(row))
+	}
+	for row := 0; row < min(table.fixedRows, rowCount) && len(table.visibleRows) < height; row++ {
+		addRow(row)
+	}
+	for row := table.fixedRows + table.rowOffset; row < rowCount && len(table.visibleRows) < height; row++ {
+		addRow(row)
+	}
+	table.adjustColumnOffset(width)
+	table.scrollToSelection = false
+
+	highlight := table.focused || !table.selectedFocusOnly
+	for y, row := range table.visibleRows {
+		cells := table.visibleCells[y]
+		for i, col := range table.visibleColumns {
+			if i > 0 && table.separator != 0 {
+				separatorStyle := table.separatorStyle
+				if highlight && table.isSelected(row, col.index) && table.isSelected(row, table.visibleColumns[i-1].index) {
+					separatorStyle = table.selectedStyle
+				}
+				screen.SetContent(col.x-1, y, table.separator, nil, separatorStyle)
+			}
+			var cell *TableCell
+			if col.index < len(cells) {
+				cell = cells[col.index]
+			}
+			var style tcell.Style
+			if highlight && table.isSelected(row, col.index) {
+				style = table.selectedStyle
+			} else if cell != nil {
+				style = cell.Style
+			} else {
+				continue
+			}
+			for x := col.x; x < col.x+col.width; x++ {
+				screen.SetContent(x, y, ' ', nil, style)
+			}
+			if cell != nil {
+				PrintWithStyle(screen, cell.Text, col.x, y, col.width, cell.Align, style)
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func newTestTable(rows, columns int) *Table {
+	table := NewTable().SetFixed(1, 1).SetSeparator('|')
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			table.SetCellSimple(row, column, fmt.Sprintf("r%dc%d", row, column))
+		}
+	}
+	return table
+}
+
+func TestTable_ZeroSize(t *testing.T) {
+	for _, size := range [][2]int{{0, 5}, {20, 0}, {0, 0}} {
+		t.Run(fmt.Sprintf("%dx%d", size[0], size[1]), func(t *testing.T) {
+			table := newTestTable(10, 6).SetSelectable(true, true).Select(4, 4)
+			RenderSnapshot(table, size[0], size[1])
+			table.OnKeyEvent(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone))
+			table.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+			table.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone), false})
+			RenderSnapshot(table, size[0], size[1])
+			if row, column := table.GetSelection(); row != 5 || column != 5 {
+				t.Errorf("expected keys to keep working without any space, got selection %d,%d", row, column)
+			}
+		})
+	}
+}
+
+func TestTable_CellSelection(t *testing.T) {
+	table := newTestTable(6, 4).SetSelectable(true, true)
+	table.GetCell(2, 1).SetSelectable(false)
+	var changes [][2]int
+	table.SetSelectionChangedFunc(func(row, column int) {
+		changes = append(changes, [2]int{row, column})
+	})
+	if row, column := table.GetSelection(); row != 1 || column != 1 {
+		t.Errorf("expected the selection to start after the fixed row and column, got %d,%d", row, column)
+	}
+	key := func(key tcell.Key) {
+		table.OnKeyEvent(tcell.NewEventKey(key, 0, tcell.ModNone))
+	}
+	// Moving down skips the unselectable cell, moving left stops at the fixed column.
+	key(tcell.KeyDown)
+	key(tcell.KeyLeft)
+	key(tcell.KeyUp)
+	key(tcell.KeyEnd)
+	key(tcell.KeyDown)
+	key(tcell.KeyHome)
+	expected := [][2]int{{3, 1}, {1, 1}, {5, 3}, {1, 1}}
+	if !slices.Equal(changes, expected) {
+		t.Errorf("expected selection changes %v, got %v", expected, changes)
+	}
+}
+
+func TestTable_RowSelectionScrolls(t *testing.T) {
+	table := newTestTable(20, 3).SetSelectable(true, false)
+	selected := -1
+	table.SetSelectedFunc(func(row, column int) {
+		selected = row
+	})
+	RenderSnapshot(table, 20, 5)
+	table.OnKeyEvent(tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModNone))
+	table.OnKeyEvent(tcell.NewEventKey(tcell.KeyPgDn, 0, tcell.ModNone))
+	RenderSnapshot(table, 20, 5)
+	if row, _ := table.GetSelection(); row != 9 {
+		t.Errorf("expected two pages of four rows to select row 9, got %d", row)
+	}
+	if rowOffset, _ := table.GetOffset(); rowOffset != 5 {
+		t.Errorf("expected the selected row to be scrolled to the bottom, got offset %d", rowOffset)
+	}
+
+	// The first click selects a row, the second one activates it.
+	click := customMouseEvent{tcell.NewEventMouse(6, 1, tcell.Button1, tcell.ModNone), false}
+	table.OnMouseEvent(click)
+	if row, _ := table.GetSelection(); row != 6 || selected != -1 {
+		t.Errorf("expected clicking the first scrolled row to select row 6, got %d", row)
+	}
+	table.OnMouseEvent(click)
+	if selected != 6 {
+		t.Errorf("expected clicking the selected row to activate it, got %d", selected)
+	}
+	if table.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(6, 0, tcell.Button1, tcell.ModNone), false}) {
+		t.Errorf("expected clicking the fixed header row not to be handled")
+	}
+}
+
+func TestTable_ColumnsScrollToSelection(t *testing.T) {
+	table := newTestTable(3, 8).SetSelectable(true, true)
+	for i := 0; i < 6; i++ {
+		table.OnKeyEvent(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone))
+	}
+	RenderSnapshot(table, 15, 3)
+	if _, column := table.GetSelection(); column != 7 {
+		t.Fatalf("expected the last column to be selected, got %d", column)
+	}
+	if _, columnOffset := table.GetOffset(); columnOffset != 5 {
+		t.Errorf("expected columns to scroll so the selection is visible, got offset %d", columnOffset)
+	}
+}
+
+func TestGolden_Table(t *testing.T) {
+	table := newTestTable(6, 4).SetSelectable(true, false).Select(2, 0)
+	table.SetColumnWidths([]int{0, -1, 6})
+	table.GetCell(3, 1).SetText("[red]long cell text[-]")
+	assertGolden(t, goldenPath("table"), table, 24, 4)
+}
+
+type countingTableSource struct {
+	count    int
+	requests []int
+}
+
+func (source *countingTableSource) Count() int {
+	return source.count
+}
+
+func (source *countingTableSource) ColumnCount() int {
+	return 3
+}
+
+func (source *countingTableSource) Row(index int) []*TableCell {
+	source.requests = append(source.requests, index)
+	// Rows may be shorter than the column count.
+	return []*TableCell{NewTableCell(fmt.Sprintf("row %d", index)), NewTableCell("x")}
+}
+
+func TestTable_SourceOnlyRequestsVisibleRows(t *testing.T) {
+	source := &countingTableSource{count: 1_000_000}
+	table := NewTable().SetFixed(1, 0).SetSelectable(true, false).SetSource(source)
+	table.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnd, 0, tcell.ModNone))
+	source.requests = nil
+	RenderSnapshot(table, 20, 4)
+	if !slices.Equal(source.requests, []int{0, 999_997, 999_998, 999_999}) {
+		t.Errorf("expected only the fixed and visible rows to be requested, got %v", source.requests)
+	}
+	if row, _ := table.GetSelection(); row != 999_999 {
+		t.Errorf("expected End to select the last row, got %d", row)
+	}
+	if table.GetRowCount() != 1_000_000 || table.GetColumnCount() != 3 {
+		t.Errorf("expected the size of the table to come from the source")
+	}
+	if cell := table.GetCell(5, 2); cell != nil {
+		t.Errorf("expected cells past the end of a short row to be nil")
+	}
+
+	table.SetCellSimple(0, 0, "own cell").SetSource(nil)
+	if cell := table.GetCell(0, 0); table.GetRowCount() != 1 || cell == nil || cell.Text != "own cell" {
+		t.Errorf("expected the table's own cells to be shown after removing the source")
+	}
+}
@@ -0,0 +1,710 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+type TabPosition int
+
+const (
+	TabsTop TabPosition = iota
+	TabsLeft
+)
+
+type tab struct {
+	name     string
+	title    string
+	badge    string
+	closable bool
+
+	// The areas of the tab and its close button within the tab strip during the last draw.
+	area      Rect
+	closeArea Rect
+}
+
+// Tabs is a container with a tab strip and one content component per tab, of which the current one is shown.
+//
+// The tab strip is drawn across the top or along the left side (see SetPosition) using the runes in Borders.
+// Tabs can have a badge, e.g. an unread count, and a close button. If there are too many tabs to fit in the strip,
+// it can be scrolled with the mouse wheel or the arrows at its ends, and it scrolls to the current tab automatically.
+//
+// Tabs are switched by clicking them or with the keys in TabsKeymap, which are handled before the content gets them.
+// The contents are kept in a Pages container, so each tab remembers its focus state when switching.
+type Tabs struct {
+	tabs     []*tab
+	current  int
+	pages    *Pages
+	position TabPosition
+
+	tabStyle       tcell.Style
+	activeTabStyle tcell.Style
+	badgeStyle     tcell.Style
+	borderStyle    tcell.Style
+	closeGlyph     string
+
+	offset            int
+	scrollToCurrent   bool
+	stripArea         Rect
+	scrollBackArea    Rect
+	scrollForwardArea Rect
+	contentScreen     *ProxyScreen
+
+	focused     bool
+	prevWidth   int
+	prevHeight  int
+	forceRedraw bool
+
+	changed func(name string)
+	close   func(name string) bool
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// TabsKeymap is the default keymap of tab containers. It can be overridden per container with SetKeymap.
+// The close-tab action isn't bound by default.
+var TabsKeymap = newDefaultKeymap("tabs", []string{
+	"previous-tab", "next-tab", "close-tab",
+	"switch-to-tab-1", "switch-to-tab-2", "switch-to-tab-3", "switch-to-tab-4", "switch-to-tab-5",
+	"switch-to-tab-6", "switch-to-tab-7", "switch-to-tab-8", "switch-to-tab-9",
+}, map[string]string{
+	"Ctrl+PgUp": "previous-tab",
+	"Ctrl+PgDn": "next-tab",
+	"Alt+1":     "switch-to-tab-1",
+	"Alt+2":     "switch-to-tab-2",
+	"Alt+3":     "switch-to-tab-3",
+	"Alt+4":     "switch-to-tab-4",
+	"Alt+5":     "switch-to-tab-5",
+	"Alt+6":     "switch-to-tab-6",
+	"Alt+7":     "switch-to-tab-7",
+	"Alt+8":     "switch-to-tab-8",
+	"Alt+9":     "switch-to-tab-9",
+})
+
+// NewTabs returns a new tab container with no tabs and the tab strip at the top.
+func NewTabs() *Tabs {
+	return &Tabs{
+		current:        -1,
+		pages:          NewPages(),
+		tabStyle:       tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+		activeTabStyle: tcell.StyleDefault.Foreground(Styles.SecondaryTextColor).Background(Styles.PrimitiveBackgroundColor).Bold(true),
+		badgeStyle:     tcell.StyleDefault.Foreground(Styles.TertiaryTextColor).Background(Styles.PrimitiveBackgroundColor).Bold(true),
+		borderStyle:    tcell.StyleDefault.Foreground(Styles.BorderColor).Background(Styles.PrimitiveBackgroundColor),
+		contentScreen:  &ProxyScreen{Style: tcell.StyleDefault},
+	}
+}
+
+// SetPosition sets whether the tab strip is drawn at the top or on the left side.
+func (tabs *Tabs) SetPosition(position TabPosition) *Tabs {
+	tabs.position = position
+	tabs.forceRedraw = true
+	return tabs
+}
+
+// AddTab adds a tab to the end of the tab strip. If a tab with the same name already exists,
+// its title and content are replaced instead. The first tab that's added becomes the current tab.
+func (tabs *Tabs) AddTab(name, title string, comp Component) *Tabs {
+	tabs.forceRedraw = true
+	if index := tabs.indexOf(name); index >= 0 {
+		tabs.tabs[index].title = title
+		tabs.pages.AddPage(name, comp, index == tabs.current)
+		return tabs
+	}
+	tabs.tabs = append(tabs.tabs, &tab{name: name, title: title})
+	tabs.pages.AddPage(name, comp, false)
+	if tabs.current < 0 {
+		tabs.setCurrent(len(tabs.tabs) - 1)
+	}
+	return tabs
+}
+
+// RemoveTab removes the tab with the given name. If it was the current tab, the next tab becomes current.
+func (tabs *Tabs) RemoveTab(name string) *Tabs {
+	index := tabs.indexOf(name)
+	if index < 0 {
+		return tabs
+	}
+	tabs.forceRedraw = true
+	tabs.tabs = append(tabs.tabs[:index], tabs.tabs[index+1:]...)
+	tabs.pages.RemovePage(name)
+	if index < tabs.current {
+		tabs.current--
+	} else if index == tabs.current {
+		tabs.current = -1
+		if len(tabs.tabs) > 0 {
+			tabs.setCurrent(min(index, len(tabs.tabs)-1))
+		} else if tabs.changed != nil {
+			tabs.changed("")
+		}
+	}
+	return tabs
+}
+
+// HasTab returns true if a tab with the given name exists.
+func (tabs *Tabs) HasTab(name string) bool {
+	return tabs.indexOf(name) >= 0
+}
+
+// GetTab returns the content component of the tab with the given name, or nil if there's no such tab.
+func (tabs *Tabs) GetTab(name string) Component {
+	return tabs.pages.GetPage(name)
+}
+
+// GetTabNames returns the names of all tabs in order.
+func (tabs *Tabs) GetTabNames() []string {
+	names := make([]string, len(tabs.tabs))
+	for i, t := range tabs.tabs {
+		names[i] = t.name
+	}
+	return names
+}
+
+func (tabs *Tabs) GetTabCount() int {
+	return len(tabs.tabs)
+}
+
+// GetCurrentTab returns the name and content of the current tab, or an empty string and nil if there are no tabs.
+func (tabs *Tabs) GetCurrentTab() (string, Component) {
+	return tabs.pages.GetFrontPage()
+}
+
+// SwitchToTab makes the tab with the given name the current tab.
+func (tabs *Tabs) SwitchToTab(name string) *Tabs {
+	if index := tabs.indexOf(name); index >= 0 {
+		tabs.setCurrent(index)
+	}
+	return tabs
+}
+
+// SetTabTitle changes the title of the tab with the given name. Color tags are supported.
+func (tabs *Tabs) SetTabTitle(name, title string) *Tabs {
+	if index := tabs.indexOf(name); index >= 0 {
+		tabs.tabs[index].title = title
+		tabs.forceRedraw = true
+	}
+	return tabs
+}
+
+// SetTabBadge sets the badge drawn after the title of the tab with the given name, e.g. an unread count or "•"
+// to signal activity. An empty string removes the badge.
+func (tabs *Tabs) SetTabBadge(name, badge string) *Tabs {
+	if index := tabs.indexOf(name); index >= 0 {
+		tabs.tabs[index].badge = badge
+		tabs.forceRedraw = true
+	}
+	return tabs
+}
+
+// SetTabClosable sets whether the tab with the given name has a close button.
+func (tabs *Tabs) SetTabClosable(name string, closable bool) *Tabs {
+	if index := tabs.indexOf(name); index >= 0 {
+		tabs.tabs[index].closable = closable
+		tabs.forceRedraw = true
+	}
+	return tabs
+}
+
+func (tabs *Tabs) SetTabStyle(style tcell.Style) *Tabs {
+	tabs.tabStyle = style
+	tabs.forceRedraw = true
+	return tabs
+}
+
+func (tabs *Tabs) SetActiveTabStyle(style tcell.Style) *Tabs {
+	tabs.activeTabStyle = style
+	tabs.forceRedraw = true
+	return tabs
+}
+
+// SetBadgeStyle sets the style of tab badges. Only the foreground and attributes are used,
+// the background comes from the tab.
+func (tabs *Tabs) SetBadgeStyle(style tcell.Style) *Tabs {
+	tabs.badgeStyle = style
+	tabs.forceRedraw = true
+	return tabs
+}
+
+func (tabs *Tabs) SetBorderStyle(style tcell.Style) *Tabs {
+	tabs.borderStyle = style
+	tabs.forceRedraw = true
+	return tabs
+}
+
+// SetCloseGlyph sets the glyph drawn as the close button of closable tabs. An empty string uses Glyphs.TabClose.
+func (tabs *Tabs) SetCloseGlyph(glyph string) *Tabs {
+	tabs.closeGlyph = glyph
+	tabs.forceRedraw = true
+	return tabs
+}
+
+// SetChangedFunc sets a function which is called when the current tab changes.
+// The name is empty if the last tab was removed.
+func (tabs *Tabs) SetChangedFunc(handler func(name string)) *Tabs {
+	tabs.changed = handler
+	return tabs
+}
+
+// SetCloseFunc sets a function which is called when the user closes a tab. The tab is only removed if the function
+// returns true. If no function is set, tabs are always removed when closed.
+func (tabs *Tabs) SetCloseFunc(handler func(name string) bool) *Tabs {
+	tabs.close = handler
+	return tabs
+}
+
+// SetKeymap sets the keymap used by this tab container. If nil, TabsKeymap is used.
+func (tabs *Tabs) SetKeymap(keymap *Keymap) *Tabs {
+	tabs.keymap = keymap
+	tabs.keyChord.Reset()
+	return tabs
+}
+
+func (tabs *Tabs) indexOf(name string) int {
+	for i, t := range tabs.tabs {
+		if t.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (tabs *Tabs) setCurrent(index int) {
+	if index == tabs.current {
+		return
+	}
+	tabs.current = index
+	tabs.scrollToCurrent = true
+	tabs.forceRedraw = true
+	tabs.pages.SwitchToPage(tabs.tabs[index].name)
+	if tabs.changed != nil {
+		tabs.changed(tabs.tabs[index].name)
+	}
+}
+
+func (tabs *Tabs) switchRelative(delta int) {
+	if count := len(tabs.tabs); count > 0 {
+		tabs.setCurrent((tabs.current + delta + count) % count)
+	}
+}
+
+func (tabs *Tabs) closeTab(index int) {
+	name := tabs.tabs[index].name
+	if tabs.close == nil || tabs.close(name) {
+		tabs.RemoveTab(name)
+	}
+}
+
+func (tabs *Tabs) getCloseGlyph() string {
+	return firstNonEmpty(tabs.closeGlyph, Glyphs.TabClose)
+}
+
+// labelWidth returns the width of the label of a tab including one cell of padding on both sides.
+func (tabs *Tabs) labelWidth(t *tab) int {
+	width := TaggedStringWidth(t.title) + 2
+	if t.badge != "" {
+		width += TaggedStringWidth(t.badge) + 1
+	}
+	if t.closable {
+		width += stringWidth(tabs.getCloseGlyph()) + 1
+	}
+	return width
+}
+
+// layoutStrip updates the scroll offset of the tab strip and returns the range of tabs to draw.
+// The sizes are the lengths of the tabs along the strip excluding the edge shared with the previous tab.
+// If the tabs don't fit, one cell at both ends of the strip is reserved for the scroll arrows.
+func (tabs *Tabs) layoutStrip(sizes []int, length int) (first, count int, overflow bool) {
+	total := 1
+	for _, size := range sizes {
+		total += size
+	}
+	if total <= length {
+		tabs.offset = 0
+		return 0, len(sizes), false
+	}
+	available := length - 2
+	fits := func(from, to int) bool {
+		sum := 1
+		for i := from; i <= to; i++ {
+			sum += sizes[i]
+		}
+		return sum <= available
+	}
+	if tabs.scrollToCurrent && tabs.current >= 0 {
+		if tabs.current < tabs.offset {
+			tabs.offset = tabs.current
+		}
+		for tabs.offset < tabs.current && !fits(tabs.offset, tabs.current) {
+			tabs.offset++
+		}
+	}
+	tabs.scrollToCurrent = false
+	tabs.offset = max(0, min(tabs.offset, len(sizes)-1))
+	// Don't leave empty space at the end if earlier tabs would fit there.
+	for tabs.offset > 0 && fits(tabs.offset-1, len(sizes)-1) {
+		tabs.offset--
+	}
+	count = 1
+	for tabs.offset+count < len(sizes) && fits(tabs.offset, tabs.offset+count) {
+		count++
+	}
+	return tabs.offset, count, true
+}
+
+// borderRune returns the box drawing rune that has lines in the given directions.
+func borderRune(up, down, left, right bool) rune {
+	switch {
+	case up && down && left && right:
+		return Borders.Cross
+	case up && down && left:
+		return Borders.RightT
+	case up && down && right:
+		return Borders.LeftT
+	case down && left && right:
+		return Borders.TopT
+	case up && left && right:
+		return Borders.BottomT
+	case down && right:
+		return Borders.TopLeft
+	case down && left:
+		return Borders.TopRight
+	case up && right:
+		return Borders.BottomLeft
+	case up && left:
+		return Borders.BottomRight
+	case up || down:
+		return Borders.Vertical
+	default:
+		return Borders.Horizontal
+	}
+}
+
+func (tabs *Tabs) drawLabel(screen Screen, t *tab, x, y, width int, style tcell.Style) {
+	for i := 0; i < width; i++ {
+		screen.SetContent(x+i, y, ' ', nil, style)
+	}
+	x, width = x+1, width-2
+	t.closeArea = Rect{}
+	if closeGlyph := tabs.getCloseGlyph(); t.closable && width > stringWidth(closeGlyph) {
+		closeWidth := stringWidth(closeGlyph)
+		t.closeArea = Rect{X: x + width - closeWidth, Y: y, Width: closeWidth, Height: 1}
+		PrintWithStyle(screen, Escape(closeGlyph), t.closeArea.X, y, closeWidth, AlignLeft, style)
+		width -= closeWidth + 1
+	}
+	if badgeWidth := TaggedStringWidth(t.badge); t.badge != "" && width > badgeWidth {
+		PrintWithStyle(screen, t.badge, x+width-badgeWidth, y, badgeWidth, AlignLeft, tabs.badgeStyle)
+		width -= badgeWidth + 1
+	}
+	PrintWithStyle(screen, t.title, x, y, width, AlignLeft, style)
+}
+
+func (tabs *Tabs) tabStyleFor(index int) tcell.Style {
+	if index == tabs.current {
+		return tabs.activeTabStyle
+	}
+	return tabs.tabStyle
+}
+
+func (tabs *Tabs) drawScrollArrows(screen Screen, first, count int, backGlyph, forwardGlyph string) {
+	backStyle, forwardStyle := tabs.borderStyle, tabs.borderStyle
+	if first > 0 {
+		backStyle = tabs.tabStyle
+	}
+	if first+count < len(tabs.tabs) {
+		forwardStyle = tabs.tabStyle
+	}
+	back, forward := tabs.scrollBackArea, tabs.scrollForwardArea
+	PrintWithStyle(screen, Escape(backGlyph), back.X, back.Y+back.Height/2, back.Width, AlignCenter, backStyle)
+	PrintWithStyle(screen, Escape(forwardGlyph), forward.X, forward.Y+forward.Height/2, forward.Width, AlignCenter, forwardStyle)
+}
+
+// drawTopStrip draws the tab strip as a row of tabs on top of a horizontal line that's open below the current tab.
+func (tabs *Tabs) drawTopStrip(screen Screen, width int) {
+	sizes := make([]int, len(tabs.tabs))
+	for i, t := range tabs.tabs {
+		sizes[i] = tabs.labelWidth(t) + 1
+	}
+	first, count, overflow := tabs.layoutStrip(sizes, width)
+	start, end := 0, width
+	if overflow {
+		start, end = 1, width-1
+		tabs.scrollBackArea = Rect{X: 0, Y: 0, Width: 1, Height: 3}
+		tabs.scrollForwardArea = Rect{X: width - 1, Y: 0, Width: 1, Height: 3}
+	}
+
+	topInterior := make([]bool, width)
+	activeStart, activeEnd := -1, -1
+	x := start
+	for i := first; i < first+count; i++ {
+		labelWidth := min(sizes[i]-1, end-x-2)
+		if labelWidth < 1 {
+			break
+		}
+		tabs.tabs[i].area = Rect{X: x, Y: 0, Width: labelWidth + 2, Height: 3}
+		for c := x + 1; c <= x+labelWidth; c++ {
+			topInterior[c] = true
+		}
+		if i == tabs.current {
+			activeStart, activeEnd = x, x+labelWidth+1
+		}
+		x += labelWidth + 1
+	}
+	baseline := func(c int) bool {
+		return c >= 0 && c < width && (c <= activeStart || c >= activeEnd)
+	}
+	isTopInterior := func(c int) bool {
+		return c >= 0 && c < width && topInterior[c]
+	}
+
+	for c := 0; c < width; c++ {
+		if baseline(c) {
+			screen.SetContent(c, 2, Borders.Horizontal, nil, tabs.borderStyle)
+		}
+	}
+	for i, t := range tabs.tabs {
+		if t.area.IsEmpty() {
+			continue
+		}
+		left, right := t.area.X, t.area.X+t.area.Width-1
+		for c := left + 1; c < right; c++ {
+			screen.SetContent(c, 0, Borders.Horizontal, nil, tabs.borderStyle)
+		}
+		tabs.drawLabel(screen, t, left+1, 1, t.area.Width-2, tabs.tabStyleFor(i))
+		for _, c := range []int{left, right} {
+			screen.SetContent(c, 0, borderRune(false, true, isTopInterior(c-1), isTopInterior(c+1)), nil, tabs.borderStyle)
+			screen.SetContent(c, 1, Borders.Vertical, nil, tabs.borderStyle)
+			screen.SetContent(c, 2, borderRune(true, false, baseline(c-1), baseline(c+1)), nil, tabs.borderStyle)
+		}
+	}
+	if overflow {
+		tabs.drawScrollArrows(screen, first, count, Glyphs.TabScrollLeft, Glyphs.TabScrollRight)
+	}
+}
+
+// drawLeftStrip draws the tab strip as a column of tabs next to a vertical line that's open next to the current tab.
+func (tabs *Tabs) drawLeftStrip(screen Screen, stripWidth, height int) {
+	sizes := make([]int, len(tabs.tabs))
+	for i := range sizes {
+		sizes[i] = 2
+	}
+	first, count, overflow := tabs.layoutStrip(sizes, height)
+	start := 0
+	if overflow {
+		start = 1
+		tabs.scrollBackArea = Rect{X: 0, Y: 0, Width: stripWidth - 1, Height: 1}
+		tabs.scrollForwardArea = Rect{X: 0, Y: height - 1, Width: stripWidth - 1, Height: 1}
+	}
+
+	separator := stripWidth - 1
+	activeRow, firstEdge, lastEdge := -1, start, start
+	edgeRows := make(map[int]bool)
+	for i := first; i < first+count; i++ {
+		y := start + (i-first)*2
+		tabs.tabs[i].area = Rect{X: 0, Y: y, Width: stripWidth, Height: 3}
+		edgeRows[y], edgeRows[y+2] = true, true
+		lastEdge = y + 2
+		if i == tabs.current {
+			activeRow = y + 1
+		}
+	}
+	separatorLine := func(y int) bool {
+		return y >= 0 && y < height && y != activeRow
+	}
+
+	for y := 0; y < height; y++ {
+		if edgeRows[y] {
+			screen.SetContent(0, y, borderRune(y > firstEdge, y < lastEdge, false, true), nil, tabs.borderStyle)
+			for x := 1; x < separator; x++ {
+				screen.SetContent(x, y, Borders.Horizontal, nil, tabs.borderStyle)
+			}
+			screen.SetContent(separator, y, borderRune(separatorLine(y-1), separatorLine(y+1), true, false), nil, tabs.borderStyle)
+		} else if separatorLine(y) {
+			screen.SetContent(separator, y, Borders.Vertical, nil, tabs.borderStyle)
+		}
+	}
+	for i, t := range tabs.tabs {
+		if !t.area.IsEmpty() {
+			screen.SetContent(0, t.area.Y+1, Borders.Vertical, nil, tabs.borderStyle)
+			tabs.drawLabel(screen, t, 1, t.area.Y+1, separator-1, tabs.tabStyleFor(i))
+		}
+	}
+	if overflow {
+		tabs.drawScrollArrows(screen, first, count, Glyphs.TabScrollUp, Glyphs.TabScrollDown)
+	}
+}
+
+func (tabs *Tabs) drawsPartially() {}
+
+func (tabs *Tabs) Draw(screen Screen) {
+	width, height := screen.Size()
+	partial := isPartialDraw(screen) && !tabs.forceRedraw && tabs.prevWidth == width && tabs.prevHeight == height
+	tabs.prevWidth, tabs.prevHeight = width, height
+	tabs.forceRedraw = false
+	if !partial {
+		screen.Clear()
+	}
+	for _, t := range tabs.tabs {
+		t.area = Rect{}
+		t.closeArea = Rect{}
+	}
+	tabs.scrollBackArea = Rect{}
+	tabs.scrollForwardArea = Rect{}
+
+	tabs.contentScreen.Parent = screen
+	if tabs.position == TabsLeft {
+		stripWidth := 0
+		for _, t := range tabs.tabs {
+			stripWidth = max(stripWidth, tabs.labelWidth(t))
+		}
+		stripWidth = min(stripWidth+2, width/2)
+		tabs.stripArea = Rect{Width: stripWidth, Height: height}
+		tabs.fillStrip(screen)
+		if stripWidth >= 3 {
+			tabs.drawLeftStrip(screen, stripWidth, height)
+		}
+		tabs.contentScreen.OffsetX, tabs.contentScreen.OffsetY = stripWidth, 0
+		tabs.contentScreen.Width, tabs.contentScreen.Height = width-stripWidth, height
+	} else {
+		stripHeight := min(3, height)
+		tabs.stripArea = Rect{Width: width, Height: stripHeight}
+		tabs.fillStrip(screen)
+		if stripHeight == 3 {
+			tabs.drawTopStrip(screen, width)
+		}
+		tabs.contentScreen.OffsetX, tabs.contentScreen.OffsetY = 0, stripHeight
+		tabs.contentScreen.Width, tabs.contentScreen.Height = width, height-stripHeight
+	}
+	drawChild(tabs.pages, tabs.contentScreen, partial, proxyStyle(screen))
+}
+
+func (tabs *Tabs) fillStrip(screen Screen) {
+	area := tabs.stripArea
+	for y := area.Y; y < area.Y+area.Height; y++ {
+		for x := area.X; x < area.X+area.Width; x++ {
+			screen.SetContent(x, y, ' ', nil, tabs.borderStyle)
+		}
+	}
+}
+
+func (tabs *Tabs) OnKeyEvent(event KeyEvent) bool {
+	keymap := tabs.keymap
+	if keymap == nil {
+		keymap = TabsKeymap
+	}
+	action, consumed := keymap.Process(&tabs.keyChord, event)
+	switch action {
+	case "previous-tab":
+		tabs.switchRelative(-1)
+	case "next-tab":
+		tabs.switchRelative(1)
+	case "close-tab":
+		if tabs.current >= 0 {
+			tabs.closeTab(tabs.current)
+		}
+	case "":
+		if consumed {
+			return true
+		}
+		return tabs.pages.OnKeyEvent(event)
+	default:
+		if number, ok := strings.CutPrefix(action, "switch-to-tab-"); ok {
+			index, _ := strconv.Atoi(number)
+			if index > 0 && index <= len(tabs.tabs) {
+				tabs.setCurrent(index - 1)
+			}
+		}
+	}
+	return true
+}
+
+func (tabs *Tabs) OnPasteEvent(event PasteEvent) bool {
+	return tabs.pages.OnPasteEvent(event)
+}
+
+func (tabs *Tabs) OnMouseEvent(event MouseEvent) bool {
+	x, y := event.Position()
+	if !tabs.stripArea.Contains(x, y) {
+		if tabs.contentScreen.IsInArea(x, y) {
+			return tabs.pages.OnMouseEvent(OffsetMouseEvent(event, -tabs.contentScreen.OffsetX, -tabs.contentScreen.OffsetY))
+		}
+		return false
+	}
+	switch event.Buttons() {
+	case tcell.WheelUp, tcell.WheelLeft:
+		tabs.offset = max(tabs.offset-1, 0)
+		return true
+	case tcell.WheelDown, tcell.WheelRight:
+		tabs.offset++
+		return true
+	case tcell.Button1:
+		if event.HasMotion() {
+			return false
+		}
+		if tabs.scrollBackArea.Contains(x, y) {
+			tabs.offset = max(tabs.offset-1, 0)
+			return true
+		} else if tabs.scrollForwardArea.Contains(x, y) {
+			tabs.offset++
+			return true
+		}
+		for i, t := range tabs.tabs {
+			if t.closeArea.Contains(x, y) {
+				tabs.closeTab(i)
+				return true
+			} else if t.area.Contains(x, y) {
+				tabs.setCurrent(i)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (tabs *Tabs) Focus() {
+	tabs.focused = true
+	tabs.pages.Focus()
+}
+
+func (tabs *Tabs) Blur() {
+	tabs.focused = false
+	tabs.pages.Blur()
+}
+
+// Children returns the Pages container that holds the contents of the tabs.
+func (tabs *Tabs) Children() []Component {
+	return []Component{tabs.pages}
+}
+
+func (tabs *Tabs) focusedChild() Component {
+	if tabs.focused {
+		return tabs.pages
+	}
+	return nil
+}
+
+func (tabs *Tabs) focusChild(comp Component) bool {
+	if comp != tabs.pages {
+		return false
+	}
+	if !tabs.focused {
+		tabs.Focus()
+	}
+	return true
+}
+
+// ChildArea returns the area of the tab contents relative to the tab container as of the last draw.
+func (tabs *Tabs) ChildArea(comp Component) (Rect, bool) {
+	if comp != tabs.pages {
+		return Rect{}, false
+	}
+	return tabs.contentScreen.area(), true
+}
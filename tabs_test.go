@@ -0,0 +1,131 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func newTestTabs(names ...string) *Tabs {
+	tabs := NewTabs()
+	for _, name := range names {
+		tabs.AddTab(name, strings.ToUpper(name[:1])+name[1:], NewTextView().SetText("content "+name))
+	}
+	return tabs
+}
+
+func TestTabs_Keys(t *testing.T) {
+	tabs := newTestTabs("one", "two", "three")
+	field := NewInputField()
+	tabs.AddTab("four", "Four", field)
+	var changes []string
+	tabs.SetChangedFunc(func(name string) {
+		changes = append(changes, name)
+	})
+	tabs.Focus()
+	keys := []struct {
+		key tcell.Key
+		r   rune
+		mod tcell.ModMask
+	}{
+		{tcell.KeyRune, '3', tcell.ModAlt},
+		{tcell.KeyPgDn, 0, tcell.ModCtrl},
+		{tcell.KeyPgDn, 0, tcell.ModCtrl},
+		{tcell.KeyPgUp, 0, tcell.ModCtrl},
+		// There's no fifth tab.
+		{tcell.KeyRune, '5', tcell.ModAlt},
+		{tcell.KeyRune, '4', tcell.ModAlt},
+	}
+	for _, key := range keys {
+		if !tabs.OnKeyEvent(tcell.NewEventKey(key.key, key.r, key.mod)) {
+			t.Errorf("expected %v to be handled", key)
+		}
+	}
+	expected := []string{"three", "four", "one", "four"}
+	if !slices.Equal(changes, expected) {
+		t.Errorf("expected tab changes %v, got %v", expected, changes)
+	}
+	// Other keys go to the content of the current tab.
+	tabs.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone))
+	if text := field.GetText(); text != "x" {
+		t.Errorf("expected unbound keys to be passed to the current tab, got %q", text)
+	}
+}
+
+func TestTabs_RemoveTab(t *testing.T) {
+	tabs := newTestTabs("one", "two", "three").SwitchToTab("two")
+	var changes []string
+	tabs.SetChangedFunc(func(name string) {
+		changes = append(changes, name)
+	})
+	tabs.RemoveTab("one")
+	if name, _ := tabs.GetCurrentTab(); name != "two" || len(changes) != 0 {
+		t.Errorf("expected removing another tab to keep the current tab, got %q", name)
+	}
+	tabs.RemoveTab("two").RemoveTab("three")
+	if !slices.Equal(changes, []string{"three", ""}) || tabs.GetTabCount() != 0 {
+		t.Errorf("expected removing the current tab to switch to the next one, got %q", changes)
+	}
+	if name, comp := tabs.GetCurrentTab(); name != "" || comp != nil {
+		t.Errorf("expected no current tab after removing all tabs")
+	}
+}
+
+func TestTabs_Mouse(t *testing.T) {
+	tabs := newTestTabs("one", "two", "three").SetTabClosable("two", true).SetTabClosable("three", true)
+	var closeRequests []string
+	tabs.SetCloseFunc(func(name string) bool {
+		closeRequests = append(closeRequests, name)
+		return name != "three"
+	})
+	click := func(text string) {
+		t.Helper()
+		lines := strings.Split(RenderSnapshot(tabs, 40, 4), "\n")
+		x := strings.Index(lines[2], text)
+		if x < 0 {
+			t.Fatalf("%q not found in the tab strip %q", text, lines[2])
+		}
+		// Skip the pipe at the start of the snapshot row.
+		x = len([]rune(lines[2][:x])) - 1
+		tabs.OnMouseEvent(customMouseEvent{tcell.NewEventMouse(x, 1, tcell.Button1, tcell.ModNone), false})
+	}
+	click("Three")
+	if name, _ := tabs.GetCurrentTab(); name != "three" {
+		t.Errorf("expected clicking a tab to switch to it, got %q", name)
+	}
+	click(Glyphs.TabClose)
+	click(Glyphs.TabClose)
+	if !slices.Equal(closeRequests, []string{"two", "three"}) || !slices.Equal(tabs.GetTabNames(), []string{"one", "three"}) {
+		t.Errorf("expected the close function to decide whether tabs are removed, got %v and tabs %v", closeRequests, tabs.GetTabNames())
+	}
+}
+
+func TestTabs_ScrollsToCurrentTab(t *testing.T) {
+	var names []string
+	for i := 1; i <= 9; i++ {
+		names = append(names, fmt.Sprintf("tab%d", i))
+	}
+	tabs := newTestTabs(names...)
+	tabs.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, '9', tcell.ModAlt))
+	strip := strings.Split(RenderSnapshot(tabs, 24, 4), "\n")[2]
+	if !strings.Contains(strip, "Tab9") || strings.Contains(strip, "Tab1") {
+		t.Errorf("expected the tab strip to scroll to the last tab, got %q", strip)
+	}
+}
+
+func TestGolden_Tabs(t *testing.T) {
+	tabs := newTestTabs("one", "two", "three").SetTabBadge("two", "3").SetTabClosable("three", true).SwitchToTab("two")
+	assertGolden(t, goldenPath("tabs"), tabs, 30, 4)
+	tabs.SetPosition(TabsLeft)
+	assertGolden(t, goldenPath("tabs_left"), tabs, 30, 5)
+}
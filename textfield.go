@@ -57,6 +57,13 @@ func (tf *TextField) SetStyle(style tcell.Style) *TextField {
 	return tf
 }
 
+// PreferredSize returns the width of the text and a height of one line.
+func (tf *TextField) PreferredSize(width, height int) (int, int) {
+	tf.Lock()
+	defer tf.Unlock()
+	return TaggedStringWidth(tf.text), 1
+}
+
 func (tf *TextField) Draw(screen Screen) {
 	tf.Lock()
 	width, _ := screen.Size()
@@ -51,7 +51,11 @@ type textViewIndex struct {
 // If the text is not scrollable, any text above the top visible line is
 // discarded.
 //
-// Use SetInputCapture() to override or modify keyboard input.
+// These are the default bindings in TextViewKeymap. Use SetKeymap() to change
+// the bindings of a single text view.
+//
+// The text view implements Dirtyable, so it's only redrawn during partial
+// redraws when its content, scroll position or settings have changed.
 //
 // # Colors
 //
@@ -84,6 +88,8 @@ type textViewIndex struct {
 // See https://github.com/rivo/tview/wiki/TextView for an example.
 type TextView struct {
 	sync.Mutex
+	// Tracks whether the text view needs to be redrawn during partial redraws.
+	DirtyTracker
 
 	// The text buffer.
 	buffer []string
@@ -161,6 +167,12 @@ type TextView struct {
 	// An optional function which is called when the user presses one of the
 	// following keys: Escape, Enter, Tab, Backtab.
 	done func(tcell.Key)
+
+	// The keymap used for navigation, or nil to use TextViewKeymap.
+	keymap *Keymap
+
+	// The state of a partially entered multi-key binding.
+	keyChord ChordState
 }
 
 // NewTextView returns a new text view.
@@ -180,6 +192,7 @@ func NewTextView() *TextView {
 // SetScrollable sets the flag that decides whether or not the text view is
 // scrollable. If true, text is kept in a buffer and can be navigated.
 func (t *TextView) SetScrollable(scrollable bool) *TextView {
+	t.MarkDirty()
 	t.scrollable = scrollable
 	if !scrollable {
 		t.trackEnd = true
@@ -191,6 +204,7 @@ func (t *TextView) SetScrollable(scrollable bool) *TextView {
 // available width being wrapped onto the next line. If false, any characters
 // beyond the available width are not displayed.
 func (t *TextView) SetWrap(wrap bool) *TextView {
+	t.MarkDirty()
 	if t.wrap != wrap {
 		t.index = nil
 	}
@@ -204,6 +218,7 @@ func (t *TextView) SetWrap(wrap bool) *TextView {
 //
 // This flag is ignored if the "wrap" flag is false.
 func (t *TextView) SetWordWrap(wrapOnWords bool) *TextView {
+	t.MarkDirty()
 	if t.wordWrap != wrapOnWords {
 		t.index = nil
 	}
@@ -214,6 +229,7 @@ func (t *TextView) SetWordWrap(wrapOnWords bool) *TextView {
 // SetTextAlign sets the text alignment within the text view. This must be
 // either AlignLeft, AlignCenter, or AlignRight.
 func (t *TextView) SetTextAlign(align int) *TextView {
+	t.MarkDirty()
 	if t.align != align {
 		t.index = nil
 	}
@@ -225,11 +241,13 @@ func (t *TextView) SetTextAlign(align int) *TextView {
 // dynamically by sending color strings in square brackets to the text view if
 // dynamic colors are enabled).
 func (t *TextView) SetTextColor(color tcell.Color) *TextView {
+	t.MarkDirty()
 	t.baseStyle = t.baseStyle.Foreground(color)
 	return t
 }
 
 func (t *TextView) SetBackgroundColor(color tcell.Color) *TextView {
+	t.MarkDirty()
 	t.baseStyle = t.baseStyle.Background(color)
 	return t
 }
@@ -245,6 +263,7 @@ func (t *TextView) SetText(text string) *TextView {
 // SetDynamicColors sets the flag that allows the text color to be changed
 // dynamically. See class description for details.
 func (t *TextView) SetDynamicColors(dynamic bool) *TextView {
+	t.MarkDirty()
 	if t.dynamicColors != dynamic {
 		t.index = nil
 	}
@@ -255,6 +274,7 @@ func (t *TextView) SetDynamicColors(dynamic bool) *TextView {
 // SetRegions sets the flag that allows to define regions in the text. See class
 // description for details.
 func (t *TextView) SetRegions(regions bool) *TextView {
+	t.MarkDirty()
 	if t.regions != regions {
 		t.index = nil
 	}
@@ -292,6 +312,7 @@ func (t *TextView) SetDoneFunc(handler func(key tcell.Key)) *TextView {
 
 // ScrollTo scrolls to the specified row and column (both starting with 0).
 func (t *TextView) ScrollTo(row, column int) *TextView {
+	t.MarkDirty()
 	if !t.scrollable {
 		return t
 	}
@@ -303,6 +324,7 @@ func (t *TextView) ScrollTo(row, column int) *TextView {
 // ScrollToBeginning scrolls to the top left corner of the text if the text view
 // is scrollable.
 func (t *TextView) ScrollToBeginning() *TextView {
+	t.MarkDirty()
 	if !t.scrollable {
 		return t
 	}
@@ -316,6 +338,7 @@ func (t *TextView) ScrollToBeginning() *TextView {
 // is scrollable. Adding new rows to the end of the text view will cause it to
 // scroll with the new data.
 func (t *TextView) ScrollToEnd() *TextView {
+	t.MarkDirty()
 	if !t.scrollable {
 		return t
 	}
@@ -332,6 +355,7 @@ func (t *TextView) GetScrollOffset() (row, column int) {
 
 // Clear removes all text from the buffer.
 func (t *TextView) Clear() *TextView {
+	t.MarkDirty()
 	t.buffer = nil
 	t.recentBytes = nil
 	t.index = nil
@@ -347,6 +371,7 @@ func (t *TextView) Clear() *TextView {
 // Calling this function will remove any previous highlights. To remove all
 // highlights, call this function without any arguments.
 func (t *TextView) Highlight(regionIDs ...string) *TextView {
+	t.MarkDirty()
 	t.highlights = make(map[string]struct{})
 	for _, id := range regionIDs {
 		if id == "" {
@@ -375,6 +400,7 @@ func (t *TextView) GetHighlights() (regionIDs []string) {
 // Nothing happens if there are no highlighted regions or if the text view is
 // not scrollable.
 func (t *TextView) ScrollToHighlight() *TextView {
+	t.MarkDirty()
 	if len(t.highlights) == 0 || !t.scrollable || !t.regions {
 		return t
 	}
@@ -518,6 +544,7 @@ func (t *TextView) Write(p []byte) (n int, err error) {
 
 	// Reset the index.
 	t.index = nil
+	t.MarkDirty()
 
 	return len(p), nil
 }
@@ -712,6 +739,27 @@ func (t *TextView) reindexBuffer(width int) {
 	}
 }
 
+// PreferredSize returns the width of the longest line and the number of lines in the text view.
+// If wrapping is enabled, the lines are wrapped at the given width.
+func (t *TextView) PreferredSize(width, height int) (int, int) {
+	t.Lock()
+	defer t.Unlock()
+	if width == t.lastWidth {
+		// This is the same index the next draw would build, so it can be kept.
+		t.reindexBuffer(width)
+		return t.longestLine, len(t.index)
+	}
+	// Measure with a temporary index, so that the one for the width the text view is drawn at stays valid.
+	index, longestLine := t.index, t.longestLine
+	fromHighlight, toHighlight, posHighlight := t.fromHighlight, t.toHighlight, t.posHighlight
+	t.index = nil
+	t.reindexBuffer(width)
+	measuredWidth, measuredHeight := t.longestLine, len(t.index)
+	t.index, t.longestLine = index, longestLine
+	t.fromHighlight, t.toHighlight, t.posHighlight = fromHighlight, toHighlight, posHighlight
+	return measuredWidth, measuredHeight
+}
+
 // Draw draws this primitive onto the screen.
 func (t *TextView) Draw(screen Screen) {
 	t.Lock()
@@ -939,57 +987,85 @@ func (t *TextView) Submit(event KeyEvent) bool {
 	return true
 }
 
-func (t *TextView) OnKeyEvent(event KeyEvent) bool {
-	key := event.Key()
+// TextViewKeymap is the default keymap of text views. It can be overridden per
+// text view with SetKeymap(). Keys with modifier combinations that aren't bound
+// are handled like the same key without modifiers, and characters that aren't
+// bound are consumed without doing anything.
+var TextViewKeymap = newDefaultKeymap("text-view", []string{
+	"scroll-top", "scroll-bottom", "scroll-up", "scroll-down", "scroll-left", "scroll-right",
+	"page-up", "page-down",
+}, map[string]string{
+	"g":      "scroll-top",
+	"Home":   "scroll-top",
+	"G":      "scroll-bottom",
+	"End":    "scroll-bottom",
+	"k":      "scroll-up",
+	"Up":     "scroll-up",
+	"j":      "scroll-down",
+	"Down":   "scroll-down",
+	"h":      "scroll-left",
+	"Left":   "scroll-left",
+	"l":      "scroll-right",
+	"Right":  "scroll-right",
+	"PgUp":   "page-up",
+	"Ctrl+B": "page-up",
+	"PgDn":   "page-down",
+	"Ctrl+F": "page-down",
+})
+
+// SetKeymap sets the keymap used for navigating the text view. If nil,
+// TextViewKeymap is used.
+func (t *TextView) SetKeymap(keymap *Keymap) *TextView {
+	t.keymap = keymap
+	t.keyChord.Reset()
+	return t
+}
 
+func (t *TextView) OnKeyEvent(event KeyEvent) bool {
 	if !t.scrollable {
 		return false
 	}
 
-	switch key {
-	case tcell.KeyRune:
-		switch event.Rune() {
-		case 'g': // Home.
-			t.trackEnd = false
-			t.lineOffset = 0
-			t.columnOffset = 0
-		case 'G': // End.
-			t.trackEnd = true
-			t.columnOffset = 0
-		case 'j': // Down.
-			t.lineOffset++
-		case 'k': // Up.
-			t.trackEnd = false
-			t.lineOffset--
-		case 'h': // Left.
-			t.columnOffset--
-		case 'l': // Right.
-			t.columnOffset++
+	keymap := t.keymap
+	if keymap == nil {
+		keymap = TextViewKeymap
+	}
+	action, consumed := keymap.Process(&t.keyChord, event)
+	if !consumed {
+		action = keymap.lookupWithoutModifiers(event)
+		if action == "" {
+			// Characters are consumed even if they're not bound, so that they don't reach parent components.
+			return event.Key() == tcell.KeyRune
 		}
-	case tcell.KeyHome:
+	}
+
+	switch action {
+	case "scroll-top":
 		t.trackEnd = false
 		t.lineOffset = 0
 		t.columnOffset = 0
-	case tcell.KeyEnd:
+	case "scroll-bottom":
 		t.trackEnd = true
 		t.columnOffset = 0
-	case tcell.KeyUp:
+	case "scroll-up":
 		t.trackEnd = false
 		t.lineOffset--
-	case tcell.KeyDown:
+	case "scroll-down":
 		t.lineOffset++
-	case tcell.KeyLeft:
+	case "scroll-left":
 		t.columnOffset--
-	case tcell.KeyRight:
+	case "scroll-right":
 		t.columnOffset++
-	case tcell.KeyPgDn, tcell.KeyCtrlF:
+	case "page-down":
 		t.lineOffset += t.pageSize
-	case tcell.KeyPgUp, tcell.KeyCtrlB:
+	case "page-up":
 		t.trackEnd = false
 		t.lineOffset -= t.pageSize
 	default:
-		return false
+		// A multi-key binding was started, nothing changes yet.
+		return true
 	}
+	t.MarkDirty()
 	return true
 }
 
@@ -1006,6 +1082,7 @@ func (t *TextView) OnMouseEvent(event MouseEvent) bool {
 	default:
 		return false
 	}
+	t.MarkDirty()
 	return true
 }
 
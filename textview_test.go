@@ -0,0 +1,53 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestTextView_PreferredSize(t *testing.T) {
+	textView := NewTextView().SetText("hello world\n[red]colored[-] text")
+	textView.SetDynamicColors(true).SetWrap(true).SetWordWrap(true)
+	if width, height := textView.PreferredSize(20, 0); width != 12 || height != 2 {
+		t.Errorf("expected 12x2 without wrapping, got %dx%d", width, height)
+	}
+
+	RenderSnapshot(textView, 20, 4)
+	index := textView.index
+	if width, height := textView.PreferredSize(6, 0); width != 6 || height != 4 {
+		t.Errorf("expected 6x4 when wrapped at 6 cells, got %dx%d", width, height)
+	}
+	if textView.lastWidth != 20 || len(textView.index) != 2 || &textView.index[0] != &index[0] {
+		t.Errorf("expected measuring at another width not to change the index used for drawing")
+	}
+	if width, height := textView.PreferredSize(20, 0); width != 12 || height != 2 {
+		t.Errorf("expected 12x2 after measuring at another width, got %dx%d", width, height)
+	}
+}
+
+func TestTextView_Keys(t *testing.T) {
+	textView := NewTextView().SetText("1\n2\n3\n4\n5\n6")
+	textView.SetScrollable(true)
+	RenderSnapshot(textView, 5, 2)
+
+	if !textView.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone)) {
+		t.Errorf("expected unbound characters to be consumed")
+	}
+	if !textView.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModAlt)) || textView.lineOffset != 1 {
+		t.Errorf("expected Alt+Down to scroll down like Down, offset is %d", textView.lineOffset)
+	}
+	if !textView.OnKeyEvent(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModAlt)) || textView.lineOffset != 2 {
+		t.Errorf("expected Alt+j to scroll down like j, offset is %d", textView.lineOffset)
+	}
+	if textView.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)) {
+		t.Errorf("expected unbound special keys not to be consumed")
+	}
+}
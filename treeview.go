@@ -0,0 +1,672 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TreeNode is a single node in a TreeView.
+//
+// Nodes don't know which tree they're in, so MarkDirty must be called on the tree after changing nodes directly.
+// Changes made through the tree view itself, like expanding nodes with the keyboard, redraw it automatically.
+type TreeNode struct {
+	text      string
+	reference any
+	style     tcell.Style
+
+	parent   *TreeNode
+	children []*TreeNode
+
+	expanded   bool
+	selectable bool
+
+	loadChildren func(node *TreeNode) []*TreeNode
+	loaded       bool
+
+	selected func()
+}
+
+// NewTreeNode returns a new collapsed, selectable node with the given text.
+func NewTreeNode(text string) *TreeNode {
+	return &TreeNode{
+		text:       text,
+		style:      tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+		selectable: true,
+	}
+}
+
+// SetText sets the text of the node. Color tags are supported.
+func (node *TreeNode) SetText(text string) *TreeNode {
+	node.text = text
+	return node
+}
+
+func (node *TreeNode) GetText() string {
+	return node.text
+}
+
+// SetReference stores any value the application wants to associate with the node.
+func (node *TreeNode) SetReference(reference any) *TreeNode {
+	node.reference = reference
+	return node
+}
+
+func (node *TreeNode) GetReference() any {
+	return node.reference
+}
+
+func (node *TreeNode) SetStyle(style tcell.Style) *TreeNode {
+	node.style = style
+	return node
+}
+
+// SetSelectable sets whether the node can be the current node. Unselectable nodes are skipped when moving up and down.
+func (node *TreeNode) SetSelectable(selectable bool) *TreeNode {
+	node.selectable = selectable
+	return node
+}
+
+func (node *TreeNode) IsSelectable() bool {
+	return node.selectable
+}
+
+// SetSelectedFunc sets a function which is called when the node is selected with Enter or a click.
+func (node *TreeNode) SetSelectedFunc(handler func()) *TreeNode {
+	node.selected = handler
+	return node
+}
+
+// SetChildrenLoader sets a function which loads the children of the node the first time it's expanded.
+// The returned nodes are added after any children the node already has.
+//
+// Nodes with a loader are shown as expandable even if they don't have any children yet.
+// The loader is called in the main loop, so it should be fast. Slow loaders can return nil and add the
+// children later with AddChild followed by TreeView.MarkDirty.
+func (node *TreeNode) SetChildrenLoader(loader func(node *TreeNode) []*TreeNode) *TreeNode {
+	node.loadChildren = loader
+	node.loaded = false
+	return node
+}
+
+// AddChild adds a child node to the end of the children of this node.
+func (node *TreeNode) AddChild(child *TreeNode) *TreeNode {
+	if child.parent != nil {
+		child.parent.RemoveChild(child)
+	}
+	child.parent = node
+	node.children = append(node.children, child)
+	return node
+}
+
+// RemoveChild removes the given child node.
+func (node *TreeNode) RemoveChild(child *TreeNode) *TreeNode {
+	index := slices.Index(node.children, child)
+	if index >= 0 {
+		node.children = slices.Delete(node.children, index, index+1)
+		child.parent = nil
+	}
+	return node
+}
+
+// SetChildren replaces all children of this node.
+func (node *TreeNode) SetChildren(children []*TreeNode) *TreeNode {
+	node.ClearChildren()
+	for _, child := range children {
+		node.AddChild(child)
+	}
+	return node
+}
+
+// ClearChildren removes all children of this node.
+// If the node has a children loader, it will be called again the next time the node is expanded.
+func (node *TreeNode) ClearChildren() *TreeNode {
+	for _, child := range node.children {
+		child.parent = nil
+	}
+	node.children = nil
+	node.loaded = false
+	return node
+}
+
+// GetChildren returns the children of this node. The slice must not be modified.
+func (node *TreeNode) GetChildren() []*TreeNode {
+	return node.children
+}
+
+// GetParent returns the parent of this node, or nil if the node is a root node.
+func (node *TreeNode) GetParent() *TreeNode {
+	return node.parent
+}
+
+// GetLevel returns the depth of the node in the tree. Root nodes are at level 0.
+func (node *TreeNode) GetLevel() (level int) {
+	for parent := node.parent; parent != nil; parent = parent.parent {
+		level++
+	}
+	return
+}
+
+// IsExpandable returns true if the node has children or a children loader that hasn't been called yet.
+func (node *TreeNode) IsExpandable() bool {
+	return len(node.children) > 0 || (node.loadChildren != nil && !node.loaded)
+}
+
+func (node *TreeNode) IsExpanded() bool {
+	return node.expanded
+}
+
+// SetExpanded expands or collapses the node. Expanding calls the children loader if it hasn't been called yet.
+func (node *TreeNode) SetExpanded(expanded bool) *TreeNode {
+	if expanded && node.loadChildren != nil && !node.loaded {
+		node.loaded = true
+		for _, child := range node.loadChildren(node) {
+			node.AddChild(child)
+		}
+	}
+	node.expanded = expanded
+	return node
+}
+
+func (node *TreeNode) Expand() *TreeNode {
+	return node.SetExpanded(true)
+}
+
+func (node *TreeNode) Collapse() *TreeNode {
+	return node.SetExpanded(false)
+}
+
+// ExpandAll expands this node and all nodes below it. This calls children loaders, so it loads the whole subtree.
+func (node *TreeNode) ExpandAll() *TreeNode {
+	node.Expand()
+	for _, child := range node.children {
+		child.ExpandAll()
+	}
+	return node
+}
+
+// CollapseAll collapses this node and all nodes below it.
+func (node *TreeNode) CollapseAll() *TreeNode {
+	node.Collapse()
+	for _, child := range node.children {
+		child.CollapseAll()
+	}
+	return node
+}
+
+// Walk calls the given function for this node and all its descendants in depth-first order, including collapsed ones.
+// If the function returns false, the children of that node are skipped.
+func (node *TreeNode) Walk(fn func(node, parent *TreeNode) bool) {
+	if fn(node, node.parent) {
+		for _, child := range node.children {
+			child.Walk(fn)
+		}
+	}
+}
+
+// TreeView shows a hierarchy of TreeNodes with guide lines, where nodes can be expanded and collapsed.
+//
+// The current node can be changed with the keys in TreeViewKeymap, the mouse wheel or by clicking.
+// Left collapses the current node or moves to its parent, and Right expands it or moves to its first child.
+// Selecting a node with Enter or by clicking it calls the selected functions, or toggles the node if it has none.
+type TreeView struct {
+	DirtyTracker
+
+	root        *TreeNode
+	current     *TreeNode
+	hideRoot    bool
+	showGuides  bool
+	focused     bool
+	offset      int
+	scrollToCur bool
+
+	// The nodes that were visible and the height of the tree during the last draw.
+	visibleNodes []*TreeNode
+	height       int
+
+	graphicsColor      tcell.Color
+	backgroundColor    tcell.Color
+	selectedStyle      tcell.Style
+	collapsedIndicator string
+	expandedIndicator  string
+	selectedFocusOnly  bool
+	toggleOnSelect     bool
+
+	changed  func(node *TreeNode)
+	selected func(node *TreeNode)
+
+	keymap   *Keymap
+	keyChord ChordState
+}
+
+// TreeViewKeymap is the default keymap of tree views. It can be overridden per tree with SetKeymap.
+var TreeViewKeymap = newDefaultKeymap("tree-view", []string{
+	"move-up", "move-down", "move-home", "move-end", "page-up", "page-down",
+	"expand", "collapse", "expand-or-child", "collapse-or-parent", "toggle", "select",
+}, map[string]string{
+	"Up":    "move-up",
+	"Down":  "move-down",
+	"Home":  "move-home",
+	"End":   "move-end",
+	"PgUp":  "page-up",
+	"PgDn":  "page-down",
+	"+":     "expand",
+	"-":     "collapse",
+	"Right": "expand-or-child",
+	"Left":  "collapse-or-parent",
+	"Space": "toggle",
+	"Enter": "select",
+})
+
+// NewTreeView returns a new empty tree view.
+func NewTreeView() *TreeView {
+	return &TreeView{
+		showGuides:         true,
+		graphicsColor:      Styles.GraphicsColor,
+		backgroundColor:    Styles.PrimitiveBackgroundColor,
+		selectedStyle:      tcell.StyleDefault.Foreground(Styles.PrimitiveBackgroundColor).Background(Styles.PrimaryTextColor),
+		collapsedIndicator: "+",
+		expandedIndicator:  "-",
+		toggleOnSelect:     true,
+	}
+}
+
+// SetRoot sets the root node of the tree and expands it.
+// The root node becomes the current node, or the first selectable top-level node if the root is hidden or can't be selected.
+func (tree *TreeView) SetRoot(root *TreeNode) *TreeView {
+	tree.MarkDirty()
+	tree.root = root
+	tree.current = nil
+	tree.offset = 0
+	if root != nil {
+		root.Expand()
+		tree.ensureCurrent(tree.collectVisibleNodes())
+	}
+	return tree
+}
+
+func (tree *TreeView) GetRoot() *TreeNode {
+	return tree.root
+}
+
+// SetHideRoot sets whether the root node is hidden, making its children the top level of the tree.
+// If the root node was the current node, the first selectable top-level node becomes current instead.
+func (tree *TreeView) SetHideRoot(hide bool) *TreeView {
+	tree.MarkDirty()
+	tree.hideRoot = hide
+	if tree.root != nil {
+		tree.ensureCurrent(tree.collectVisibleNodes())
+	}
+	return tree
+}
+
+// SetShowGuides sets whether guide lines are drawn to show the hierarchy.
+func (tree *TreeView) SetShowGuides(show bool) *TreeView {
+	tree.MarkDirty()
+	tree.showGuides = show
+	return tree
+}
+
+// SetIndicators sets the strings drawn in front of collapsed and expanded nodes. Empty strings disable the indicators.
+func (tree *TreeView) SetIndicators(collapsed, expanded string) *TreeView {
+	tree.MarkDirty()
+	tree.collapsedIndicator = collapsed
+	tree.expandedIndicator = expanded
+	return tree
+}
+
+func (tree *TreeView) SetGraphicsColor(color tcell.Color) *TreeView {
+	tree.MarkDirty()
+	tree.graphicsColor = color
+	return tree
+}
+
+func (tree *TreeView) SetBackgroundColor(color tcell.Color) *TreeView {
+	tree.MarkDirty()
+	tree.backgroundColor = color
+	return tree
+}
+
+func (tree *TreeView) SetSelectedStyle(style tcell.Style) *TreeView {
+	tree.MarkDirty()
+	tree.selectedStyle = style
+	return tree
+}
+
+// SetSelectedFocusOnly sets whether the current node is only highlighted when the tree is focused.
+func (tree *TreeView) SetSelectedFocusOnly(focusOnly bool) *TreeView {
+	tree.MarkDirty()
+	tree.selectedFocusOnly = focusOnly
+	return tree
+}
+
+// SetToggleOnSelect sets whether selecting a node without any selected functions toggles it. Enabled by default.
+func (tree *TreeView) SetToggleOnSelect(toggle bool) *TreeView {
+	tree.toggleOnSelect = toggle
+	return tree
+}
+
+// SetChangedFunc sets a function which is called when the current node changes.
+func (tree *TreeView) SetChangedFunc(handler func(node *TreeNode)) *TreeView {
+	tree.changed = handler
+	return tree
+}
+
+// SetSelectedFunc sets a function which is called when a node is selected, after the node's own selected function.
+func (tree *TreeView) SetSelectedFunc(handler func(node *TreeNode)) *TreeView {
+	tree.selected = handler
+	return tree
+}
+
+// SetKeymap sets the keymap used by this tree. If nil, TreeViewKeymap is used.
+func (tree *TreeView) SetKeymap(keymap *Keymap) *TreeView {
+	tree.keymap = keymap
+	tree.keyChord.Reset()
+	return tree
+}
+
+// SetCurrentNode changes the current node and expands all its ancestors to make it visible.
+func (tree *TreeView) SetCurrentNode(node *TreeNode) *TreeView {
+	if node == tree.current {
+		return tree
+	}
+	tree.MarkDirty()
+	if node != nil {
+		for parent := node.parent; parent != nil; parent = parent.parent {
+			parent.Expand()
+		}
+	}
+	tree.current = node
+	tree.scrollToCur = true
+	if tree.changed != nil {
+		tree.changed(node)
+	}
+	return tree
+}
+
+// GetCurrentNode returns the current node, or nil if there isn't one.
+func (tree *TreeView) GetCurrentNode() *TreeNode {
+	return tree.current
+}
+
+// SelectNode makes the given node current and calls the selected functions.
+func (tree *TreeView) SelectNode(node *TreeNode) *TreeView {
+	if node == nil {
+		return tree
+	}
+	tree.SetCurrentNode(node)
+	if node.selected == nil && tree.selected == nil {
+		if tree.toggleOnSelect && node.IsExpandable() {
+			tree.MarkDirty()
+			node.SetExpanded(!node.expanded)
+		}
+		return tree
+	}
+	if node.selected != nil {
+		node.selected()
+	}
+	if tree.selected != nil {
+		tree.selected(node)
+	}
+	return tree
+}
+
+// collectVisibleNodes returns the nodes that aren't hidden inside collapsed nodes, in the order they're drawn.
+func (tree *TreeView) collectVisibleNodes() (nodes []*TreeNode) {
+	if tree.root == nil {
+		return nil
+	}
+	var collect func(node *TreeNode)
+	collect = func(node *TreeNode) {
+		nodes = append(nodes, node)
+		if node.expanded {
+			for _, child := range node.children {
+				collect(child)
+			}
+		}
+	}
+	if tree.hideRoot {
+		for _, child := range tree.root.children {
+			collect(child)
+		}
+	} else {
+		collect(tree.root)
+	}
+	return
+}
+
+// ensureCurrent makes sure the current node is one of the given visible nodes.
+// If the current node is inside a collapsed node, the closest visible ancestor becomes current.
+//
+// This calls the changed function, so it must only be used when handling changes and events, not while drawing.
+func (tree *TreeView) ensureCurrent(nodes []*TreeNode) int {
+	for node := tree.current; node != nil; node = node.parent {
+		if index := slices.Index(nodes, node); index >= 0 {
+			if node != tree.current {
+				tree.SetCurrentNode(node)
+			}
+			return index
+		}
+	}
+	for index, node := range nodes {
+		if node.selectable {
+			tree.SetCurrentNode(node)
+			return index
+		}
+	}
+	return -1
+}
+
+// moveCurrent moves the current node by the given amount of visible nodes, skipping unselectable nodes.
+func (tree *TreeView) moveCurrent(delta int) {
+	nodes := tree.collectVisibleNodes()
+	index := tree.ensureCurrent(nodes)
+	if index < 0 || delta == 0 {
+		return
+	}
+	step := sign(delta)
+	target := max(0, min(index+delta, len(nodes)-1))
+	for candidate := target; candidate >= 0 && candidate < len(nodes); candidate += step {
+		if nodes[candidate].selectable {
+			tree.SetCurrentNode(nodes[candidate])
+			return
+		}
+	}
+	// Nothing selectable past the target, so look back towards the current node instead.
+	for candidate := target; candidate != index; candidate -= step {
+		if nodes[candidate].selectable {
+			tree.SetCurrentNode(nodes[candidate])
+			return
+		}
+	}
+}
+
+func (tree *TreeView) pageSize() int {
+	return max(tree.height, 1)
+}
+
+func (tree *TreeView) OnKeyEvent(event KeyEvent) bool {
+	keymap := tree.keymap
+	if keymap == nil {
+		keymap = TreeViewKeymap
+	}
+	action, consumed := keymap.Process(&tree.keyChord, event)
+	if !consumed {
+		return false
+	}
+	// Nodes may have been collapsed or removed directly since the current node was chosen.
+	tree.ensureCurrent(tree.collectVisibleNodes())
+	current := tree.current
+	switch action {
+	case "move-up":
+		tree.moveCurrent(-1)
+	case "move-down":
+		tree.moveCurrent(1)
+	case "move-home":
+		tree.moveCurrent(-len(tree.collectVisibleNodes()))
+	case "move-end":
+		tree.moveCurrent(len(tree.collectVisibleNodes()))
+	case "page-up":
+		tree.moveCurrent(-tree.pageSize())
+	case "page-down":
+		tree.moveCurrent(tree.pageSize())
+	case "expand", "collapse", "toggle":
+		if current != nil {
+			tree.MarkDirty()
+			current.SetExpanded(action == "expand" || (action == "toggle" && !current.expanded))
+		}
+	case "expand-or-child":
+		if current == nil {
+			break
+		} else if current.IsExpandable() && !current.expanded {
+			tree.MarkDirty()
+			current.Expand()
+		} else if len(current.children) > 0 && current.expanded {
+			tree.moveCurrent(1)
+		}
+	case "collapse-or-parent":
+		if current == nil {
+			break
+		} else if current.expanded && current.IsExpandable() {
+			tree.MarkDirty()
+			current.Collapse()
+		} else if parent := current.parent; parent != nil && (parent != tree.root || !tree.hideRoot) && parent.selectable {
+			tree.SetCurrentNode(parent)
+		}
+	case "select":
+		tree.SelectNode(current)
+	}
+	return true
+}
+
+func (tree *TreeView) OnMouseEvent(event MouseEvent) bool {
+	switch event.Buttons() {
+	case tcell.Button1:
+		if event.HasMotion() {
+			return false
+		}
+		_, y := event.Position()
+		if y < 0 || y >= len(tree.visibleNodes) || !tree.visibleNodes[y].selectable {
+			return false
+		}
+		node := tree.visibleNodes[y]
+		if node == tree.current {
+			tree.SelectNode(node)
+		} else {
+			tree.SetCurrentNode(node)
+		}
+	case tcell.WheelUp:
+		tree.MarkDirty()
+		tree.offset--
+	case tcell.WheelDown:
+		tree.MarkDirty()
+		tree.offset++
+	default:
+		return false
+	}
+	return true
+}
+
+func (tree *TreeView) OnPasteEvent(event PasteEvent) bool {
+	return false
+}
+
+func (tree *TreeView) Focus() {
+	tree.MarkDirty()
+	tree.focused = true
+}
+
+func (tree *TreeView) Blur() {
+	tree.MarkDirty()
+	tree.focused = false
+}
+
+func (tree *TreeView) Draw(screen Screen) {
+	width, height := screen.Size()
+	tree.height = height
+	screen.SetStyle(tcell.StyleDefault.Background(tree.backgroundColor))
+	screen.Clear()
+	nodes := tree.collectVisibleNodes()
+	currentIndex := slices.Index(nodes, tree.current)
+	if tree.scrollToCur && currentIndex >= 0 {
+		if currentIndex < tree.offset {
+			tree.offset = currentIndex
+		} else if currentIndex >= tree.offset+height {
+			tree.offset = currentIndex - height + 1
+		}
+	}
+	tree.scrollToCur = false
+	tree.offset = max(0, min(tree.offset, len(nodes)-height))
+	tree.visibleNodes = nodes[tree.offset:min(tree.offset+height, len(nodes))]
+
+	topLevel := 0
+	if tree.hideRoot {
+		topLevel = 1
+	}
+	graphicsStyle := tcell.StyleDefault.Foreground(tree.graphicsColor).Background(tree.backgroundColor)
+	highlight := tree.focused || !tree.selectedFocusOnly
+	for y, node := range tree.visibleNodes {
+		x := 0
+		level := node.GetLevel() - topLevel
+		if tree.showGuides && level > 0 {
+			// The guides of each ancestor level continue if the ancestor has more siblings below it.
+			x = level * 2
+			ancestor := node
+			for col := level - 1; col >= 0; col-- {
+				isLast := isLastChild(ancestor)
+				var guide rune
+				switch {
+				case ancestor == node && isLast:
+					guide = BoxDrawingsLightUpAndRight
+				case ancestor == node:
+					guide = BoxDrawingsLightVerticalAndRight
+				case !isLast:
+					guide = BoxDrawingsLightVertical
+				}
+				if guide != 0 {
+					screen.SetContent(col*2, y, guide, nil, graphicsStyle)
+				}
+				if ancestor == node {
+					screen.SetContent(col*2+1, y, BoxDrawingsLightHorizontal, nil, graphicsStyle)
+				}
+				ancestor = ancestor.parent
+			}
+		} else if level > 0 {
+			x = level * 2
+		}
+		indicator := ""
+		if node.IsExpandable() {
+			indicator = tree.collapsedIndicator
+			if node.expanded {
+				indicator = tree.expandedIndicator
+			}
+		}
+		if indicator != "" {
+			PrintWithStyle(screen, indicator, x, y, width-x, AlignLeft, graphicsStyle)
+			x += TaggedStringWidth(indicator) + 1
+		}
+		style := node.style
+		if highlight && node == tree.current {
+			style = tree.selectedStyle
+			for cx := x; cx < min(x+TaggedStringWidth(node.text), width); cx++ {
+				screen.SetContent(cx, y, ' ', nil, style)
+			}
+		}
+		PrintWithStyle(screen, node.text, x, y, width-x, AlignLeft, style)
+	}
+}
+
+func isLastChild(node *TreeNode) bool {
+	if node.parent == nil {
+		return true
+	}
+	siblings := node.parent.children
+	return siblings[len(siblings)-1] == node
+}
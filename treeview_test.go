@@ -0,0 +1,148 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// newTestTree returns the following tree, with everything except b expanded:
+//
+//	root
+//	├─a
+//	│ ├─a1
+//	│ └─a2
+//	│   └─a2x
+//	└─b (children are loaded on demand)
+func newTestTree() (root *TreeNode, nodes map[string]*TreeNode) {
+	nodes = make(map[string]*TreeNode)
+	node := func(text string, children ...*TreeNode) *TreeNode {
+		nodes[text] = NewTreeNode(text).SetChildren(children)
+		return nodes[text]
+	}
+	root = node("root", node("a", node("a1"), node("a2", node("a2x"))), node("b"))
+	nodes["b"].SetChildrenLoader(func(node *TreeNode) []*TreeNode {
+		return []*TreeNode{NewTreeNode("b1"), NewTreeNode("b2")}
+	})
+	nodes["a"].ExpandAll()
+	return
+}
+
+func TestTreeView_SetRootMakesRootCurrent(t *testing.T) {
+	root, nodes := newTestTree()
+	tree := NewTreeView().SetRoot(root)
+	if current := tree.GetCurrentNode(); current != root {
+		t.Errorf("expected the root node to become current, got %v", current)
+	}
+	tree.SetHideRoot(true)
+	if current := tree.GetCurrentNode(); current != nodes["a"] {
+		t.Errorf("expected hiding the root to make the first top-level node current, got %v", current)
+	}
+
+	root.SetSelectable(false)
+	tree = NewTreeView().SetRoot(root)
+	if current := tree.GetCurrentNode(); current != nodes["a"] {
+		t.Errorf("expected the first selectable node to become current, got %v", current)
+	}
+	if NewTreeView().SetRoot(nil).GetCurrentNode() != nil {
+		t.Errorf("expected an empty tree not to have a current node")
+	}
+}
+
+func TestTreeView_DrawDoesNotChangeCurrentNode(t *testing.T) {
+	root, nodes := newTestTree()
+	var changes []string
+	tree := NewTreeView().SetRoot(root).SetCurrentNode(nodes["a2x"]).SetChangedFunc(func(node *TreeNode) {
+		changes = append(changes, node.GetText())
+	})
+	nodes["a"].Collapse()
+	tree.MarkDirty()
+	RenderSnapshot(tree, 20, 5)
+	if len(changes) != 0 || tree.GetCurrentNode() != nodes["a2x"] {
+		t.Errorf("expected drawing not to change the current node, got changes %v", changes)
+	}
+
+	// The next key press moves from the closest visible ancestor.
+	tree.OnKeyEvent(tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone))
+	if !slices.Equal(changes, []string{"a", "b"}) {
+		t.Errorf("expected the hidden current node to be replaced before moving, got changes %v", changes)
+	}
+}
+
+func TestTreeView_Keys(t *testing.T) {
+	root, nodes := newTestTree()
+	nodes["a1"].SetSelectable(false)
+	selected := 0
+	nodes["a2x"].SetSelectedFunc(func() {
+		selected++
+	})
+	tree := NewTreeView().SetRoot(root)
+	RenderSnapshot(tree, 20, 8)
+	steps := []struct {
+		key      tcell.Key
+		r        rune
+		expected string
+	}{
+		// Unselectable nodes are skipped.
+		{tcell.KeyDown, 0, "a"},
+		{tcell.KeyDown, 0, "a2"},
+		{tcell.KeyRight, 0, "a2x"},
+		{tcell.KeyEnter, 0, "a2x"},
+		{tcell.KeyLeft, 0, "a2"},
+		// Left collapses an expanded node first and moves to the parent afterwards.
+		{tcell.KeyLeft, 0, "a2"},
+		{tcell.KeyLeft, 0, "a"},
+		{tcell.KeyEnd, 0, "b"},
+		// Right loads and expands b, then moves to its first child.
+		{tcell.KeyRight, 0, "b"},
+		{tcell.KeyRight, 0, "b1"},
+		{tcell.KeyHome, 0, "root"},
+	}
+	for i, step := range steps {
+		tree.OnKeyEvent(tcell.NewEventKey(step.key, step.r, tcell.ModNone))
+		if current := tree.GetCurrentNode().GetText(); current != step.expected {
+			t.Fatalf("step %d: expected %q to be current, got %q", i, step.expected, current)
+		}
+	}
+	if selected != 1 {
+		t.Errorf("expected Enter to call the selected function of the node once, got %d calls", selected)
+	}
+	if nodes["a2"].IsExpanded() || len(nodes["b"].GetChildren()) != 2 {
+		t.Errorf("expected a2 to be collapsed and the children of b to be loaded")
+	}
+
+	// Enter toggles nodes without selected functions.
+	tree.OnKeyEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	if root.IsExpanded() {
+		t.Errorf("expected Enter to collapse the root node")
+	}
+}
+
+func TestTreeView_Mouse(t *testing.T) {
+	root, nodes := newTestTree()
+	tree := NewTreeView().SetRoot(root)
+	RenderSnapshot(tree, 20, 8)
+	click := customMouseEvent{tcell.NewEventMouse(4, 3, tcell.Button1, tcell.ModNone), false}
+	tree.OnMouseEvent(click)
+	if tree.GetCurrentNode() != nodes["a2"] || !nodes["a2"].IsExpanded() {
+		t.Errorf("expected the first click to make the node current")
+	}
+	tree.OnMouseEvent(click)
+	if nodes["a2"].IsExpanded() {
+		t.Errorf("expected the second click to toggle the node")
+	}
+}
+
+func TestGolden_TreeView(t *testing.T) {
+	root, nodes := newTestTree()
+	tree := NewTreeView().SetRoot(root).SetCurrentNode(nodes["a2"])
+	assertGolden(t, goldenPath("treeview"), tree, 16, 6)
+}
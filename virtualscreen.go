@@ -0,0 +1,260 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// Rect is a rectangular area on a screen.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// IsEmpty returns true if the rectangle doesn't contain any cells.
+func (r Rect) IsEmpty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Contains returns true if the given cell is inside the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Union returns the smallest rectangle that contains both rectangles.
+func (r Rect) Union(other Rect) Rect {
+	if r.IsEmpty() {
+		return other
+	} else if other.IsEmpty() {
+		return r
+	}
+	x, y := min(r.X, other.X), min(r.Y, other.Y)
+	return Rect{
+		X:      x,
+		Y:      y,
+		Width:  max(r.X+r.Width, other.X+other.Width) - x,
+		Height: max(r.Y+r.Height, other.Y+other.Height) - y,
+	}
+}
+
+type virtualCell struct {
+	mainc rune
+	combc []rune
+	style tcell.Style
+	width int
+}
+
+func (cell *virtualCell) equals(mainc rune, combc []rune, style tcell.Style) bool {
+	return cell.mainc == mainc && cell.style == style && slices.Equal(cell.combc, combc)
+}
+
+// VirtualScreen is a buffer-backed Screen that isn't connected to a terminal.
+//
+// It keeps track of which cells have changed since the last call to Flush, which makes it usable as a back buffer
+// that only pushes changed cells to a real screen. It can also be used for offscreen rendering and compositing.
+type VirtualScreen struct {
+	width, height int
+	cells         []virtualCell
+	dirty         []bool
+	damage        Rect
+	style         tcell.Style
+
+	cursorX, cursorY int
+	cursorVisible    bool
+}
+
+var _ Screen = (*VirtualScreen)(nil)
+
+// NewVirtualScreen creates a new virtual screen of the given size. All cells are initially marked as changed.
+func NewVirtualScreen(width, height int) *VirtualScreen {
+	vs := &VirtualScreen{style: tcell.StyleDefault}
+	vs.Resize(width, height)
+	return vs
+}
+
+// Resize changes the size of the screen. Content that fits in the new size is kept, but all cells are marked as changed.
+func (vs *VirtualScreen) Resize(width, height int) {
+	width, height = max(width, 0), max(height, 0)
+	cells := make([]virtualCell, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < vs.width && y < vs.height {
+				cells[y*width+x] = vs.cells[y*vs.width+x]
+			} else {
+				cells[y*width+x] = virtualCell{mainc: ' ', style: vs.style, width: 1}
+			}
+		}
+	}
+	vs.width, vs.height = width, height
+	vs.cells = cells
+	vs.dirty = make([]bool, width*height)
+	vs.Invalidate()
+}
+
+// Invalidate marks all cells as changed, so that the next Flush pushes the whole screen.
+func (vs *VirtualScreen) Invalidate() {
+	for i := range vs.dirty {
+		vs.dirty[i] = true
+	}
+	vs.damage = Rect{Width: vs.width, Height: vs.height}
+}
+
+// IsDirty returns true if the given cell has changed since the last flush.
+func (vs *VirtualScreen) IsDirty(x, y int) bool {
+	if !vs.inBounds(x, y) {
+		return false
+	}
+	return vs.dirty[y*vs.width+x]
+}
+
+// Damage returns the bounding box of all cells that have changed since the last flush.
+func (vs *VirtualScreen) Damage() Rect {
+	return vs.damage
+}
+
+// Flush copies all cells that have changed since the last flush to the given screen and marks them as unchanged.
+// The cursor position is also copied. The return value is the number of cells that were copied.
+func (vs *VirtualScreen) Flush(target Screen) int {
+	count := 0
+	damage := vs.damage
+	for y := damage.Y; y < damage.Y+damage.Height; y++ {
+		for x := damage.X; x < damage.X+damage.Width; x++ {
+			index := y*vs.width + x
+			if !vs.dirty[index] {
+				continue
+			}
+			cell := &vs.cells[index]
+			target.SetContent(x, y, cell.mainc, cell.combc, cell.style)
+			vs.dirty[index] = false
+			count++
+		}
+	}
+	vs.damage = Rect{}
+	if vs.cursorVisible {
+		target.ShowCursor(vs.cursorX, vs.cursorY)
+	} else {
+		target.HideCursor()
+	}
+	return count
+}
+
+// DrawTo copies the whole content of this screen onto the given screen at the given offset without affecting
+// the change tracking. It can be used to composite offscreen-rendered components.
+func (vs *VirtualScreen) DrawTo(target Screen, offsetX, offsetY int) {
+	for y := 0; y < vs.height; y++ {
+		for x := 0; x < vs.width; x++ {
+			cell := &vs.cells[y*vs.width+x]
+			target.SetContent(x+offsetX, y+offsetY, cell.mainc, cell.combc, cell.style)
+		}
+	}
+}
+
+// Snapshot returns a snapshot of the screen content in the format described in FormatSnapshot.
+func (vs *VirtualScreen) Snapshot() string {
+	cells := make([]tcell.SimCell, len(vs.cells))
+	for i, cell := range vs.cells {
+		cells[i] = tcell.SimCell{
+			Style: cell.style,
+			Runes: append([]rune{cell.mainc}, cell.combc...),
+		}
+	}
+	return FormatSnapshot(cells, vs.width, vs.height)
+}
+
+func (vs *VirtualScreen) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < vs.width && y < vs.height
+}
+
+func (vs *VirtualScreen) Clear() {
+	vs.Fill(' ', vs.style)
+}
+
+func (vs *VirtualScreen) Fill(r rune, style tcell.Style) {
+	for y := 0; y < vs.height; y++ {
+		for x := 0; x < vs.width; x++ {
+			vs.SetContent(x, y, r, nil, style)
+		}
+	}
+}
+
+func (vs *VirtualScreen) SetStyle(style tcell.Style) {
+	vs.style = style
+}
+
+func (vs *VirtualScreen) SetCell(x, y int, style tcell.Style, ch ...rune) {
+	if len(ch) > 0 {
+		vs.SetContent(x, y, ch[0], ch[1:], style)
+	} else {
+		vs.SetContent(x, y, ' ', nil, style)
+	}
+}
+
+func (vs *VirtualScreen) GetContent(x, y int) (mainc rune, combc []rune, style tcell.Style, width int) {
+	if !vs.inBounds(x, y) {
+		return 0, nil, tcell.StyleDefault, 0
+	}
+	cell := &vs.cells[y*vs.width+x]
+	return cell.mainc, cell.combc, cell.style, cell.width
+}
+
+func (vs *VirtualScreen) SetContent(x int, y int, mainc rune, combc []rune, style tcell.Style) {
+	if !vs.inBounds(x, y) {
+		return
+	}
+	index := y*vs.width + x
+	cell := &vs.cells[index]
+	if cell.equals(mainc, combc, style) {
+		return
+	}
+	cell.mainc = mainc
+	cell.combc = slices.Clone(combc)
+	cell.style = style
+	cell.width = runewidth.RuneWidth(mainc)
+	if !vs.dirty[index] {
+		vs.dirty[index] = true
+		vs.damage = vs.damage.Union(Rect{X: x, Y: y, Width: 1, Height: 1})
+	}
+}
+
+func (vs *VirtualScreen) ShowCursor(x int, y int) {
+	vs.cursorX, vs.cursorY = x, y
+	vs.cursorVisible = true
+}
+
+func (vs *VirtualScreen) HideCursor() {
+	vs.cursorVisible = false
+}
+
+// GetCursor returns the position of the cursor and whether it's visible.
+func (vs *VirtualScreen) GetCursor() (x, y int, visible bool) {
+	return vs.cursorX, vs.cursorY, vs.cursorVisible
+}
+
+func (vs *VirtualScreen) Size() (int, int) {
+	return vs.width, vs.height
+}
+
+func (vs *VirtualScreen) Colors() int {
+	return 256
+}
+
+func (vs *VirtualScreen) CharacterSet() string {
+	return "UTF-8"
+}
+
+func (vs *VirtualScreen) CanDisplay(r rune, checkFallbacks bool) bool {
+	return true
+}
+
+func (vs *VirtualScreen) HasKey(tcell.Key) bool {
+	return false
+}
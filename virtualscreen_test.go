@@ -0,0 +1,201 @@
+// mauview - A Go TUI library based on tcell.
+// Copyright © 2026 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mauview
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+type cellPos struct {
+	X, Y int
+}
+
+// recordingScreen is a virtual screen that records the positions of all cells written to it.
+type recordingScreen struct {
+	*VirtualScreen
+	written []cellPos
+}
+
+func (rs *recordingScreen) SetContent(x, y int, mainc rune, combc []rune, style tcell.Style) {
+	rs.written = append(rs.written, cellPos{x, y})
+	rs.VirtualScreen.SetContent(x, y, mainc, combc, style)
+}
+
+func TestVirtualScreen_Damage(t *testing.T) {
+	vs := NewVirtualScreen(10, 5)
+	if damage := vs.Damage(); damage != (Rect{Width: 10, Height: 5}) || !vs.IsDirty(9, 4) {
+		t.Errorf("expected a new screen to be fully damaged, got %v", damage)
+	}
+	vs.Flush(NewVirtualScreen(10, 5))
+	if damage := vs.Damage(); !damage.IsEmpty() || vs.IsDirty(0, 0) {
+		t.Errorf("expected flushing to clear the damage, got %v", damage)
+	}
+
+	vs.SetContent(2, 1, 'a', nil, tcell.StyleDefault)
+	vs.SetContent(5, 3, 'b', nil, tcell.StyleDefault)
+	// Writing the same content again or outside the screen doesn't damage anything.
+	vs.SetContent(0, 0, ' ', nil, tcell.StyleDefault)
+	vs.SetContent(10, 0, 'c', nil, tcell.StyleDefault)
+	vs.SetContent(-1, 2, 'c', nil, tcell.StyleDefault)
+	if damage := vs.Damage(); damage != (Rect{X: 2, Y: 1, Width: 4, Height: 3}) {
+		t.Errorf("expected the damage to be the bounding box of the changed cells, got %v", damage)
+	}
+	if !vs.IsDirty(2, 1) || !vs.IsDirty(5, 3) || vs.IsDirty(3, 2) || vs.IsDirty(0, 0) || vs.IsDirty(10, 0) {
+		t.Errorf("expected only the changed cells to be dirty")
+	}
+	vs.SetContent(2, 1, 'a', nil, tcell.StyleDefault.Bold(true))
+	if !vs.IsDirty(2, 1) || vs.Damage() != (Rect{X: 2, Y: 1, Width: 4, Height: 3}) {
+		t.Errorf("expected a style change to keep the cell dirty")
+	}
+}
+
+func TestVirtualScreen_FlushOnlyDamagedCells(t *testing.T) {
+	vs := NewVirtualScreen(10, 5)
+	target := &recordingScreen{VirtualScreen: NewVirtualScreen(10, 5)}
+	if count := vs.Flush(target); count != 50 || len(target.written) != 50 {
+		t.Errorf("expected the first flush to write all 50 cells, got %d (%d written)", count, len(target.written))
+	}
+
+	target.written = nil
+	vs.SetContent(2, 1, 'a', nil, tcell.StyleDefault)
+	vs.SetContent(5, 3, 'b', nil, tcell.StyleDefault)
+	vs.ShowCursor(4, 2)
+	if count := vs.Flush(target); count != 2 {
+		t.Errorf("expected 2 cells to be flushed, got %d", count)
+	}
+	if expected := []cellPos{{2, 1}, {5, 3}}; !slices.Equal(target.written, expected) {
+		t.Errorf("expected only %v to be written, got %v", expected, target.written)
+	}
+	if mainc, _, _, _ := target.GetContent(5, 3); mainc != 'b' {
+		t.Errorf("expected the changed cell to be copied, got %q", mainc)
+	}
+	if x, y, visible := target.GetCursor(); x != 4 || y != 2 || !visible {
+		t.Errorf("expected the cursor to be copied, got %d,%d (visible: %t)", x, y, visible)
+	}
+
+	target.written = nil
+	vs.HideCursor()
+	if count := vs.Flush(target); count != 0 || len(target.written) != 0 {
+		t.Errorf("expected nothing to be flushed without changes, got %d", count)
+	}
+	if _, _, visible := target.GetCursor(); visible {
+		t.Errorf("expected the cursor to be hidden")
+	}
+}
+
+func TestVirtualScreen_DrawTo(t *testing.T) {
+	vs := NewVirtualScreen(3, 2)
+	vs.SetContent(1, 1, 'x', nil, tcell.StyleDefault)
+	vs.Flush(NewVirtualScreen(3, 2))
+	target := &recordingScreen{VirtualScreen: NewVirtualScreen(10, 5)}
+	vs.DrawTo(target, 4, 2)
+	expected := []cellPos{{4, 2}, {5, 2}, {6, 2}, {4, 3}, {5, 3}, {6, 3}}
+	if !slices.Equal(target.written, expected) {
+		t.Errorf("expected the whole screen to be written at the offset, got %v", target.written)
+	}
+	if mainc, _, _, _ := target.GetContent(5, 3); mainc != 'x' {
+		t.Errorf("expected the content to be copied, got %q", mainc)
+	}
+	if vs.IsDirty(1, 1) || !vs.Damage().IsEmpty() {
+		t.Errorf("expected DrawTo not to affect the change tracking")
+	}
+}
+
+func TestVirtualScreen_Resize(t *testing.T) {
+	vs := NewVirtualScreen(4, 2)
+	vs.SetContent(1, 1, 'a', nil, tcell.StyleDefault)
+	vs.SetContent(3, 0, 'b', nil, tcell.StyleDefault)
+	vs.Flush(NewVirtualScreen(4, 2))
+
+	vs.Resize(3, 3)
+	if width, height := vs.Size(); width != 3 || height != 3 {
+		t.Fatalf("expected the size to be 3x3, got %dx%d", width, height)
+	}
+	if damage := vs.Damage(); damage != (Rect{Width: 3, Height: 3}) || !vs.IsDirty(0, 0) || !vs.IsDirty(2, 2) {
+		t.Errorf("expected resizing to invalidate the whole screen, got %v", damage)
+	}
+	if mainc, _, _, _ := vs.GetContent(1, 1); mainc != 'a' {
+		t.Errorf("expected content inside the new size to be kept, got %q", mainc)
+	}
+	if mainc, _, _, width := vs.GetContent(1, 2); mainc != ' ' || width != 1 {
+		t.Errorf("expected new cells to be blank, got %q with width %d", mainc, width)
+	}
+	target := &recordingScreen{VirtualScreen: NewVirtualScreen(3, 3)}
+	if count := vs.Flush(target); count != 9 {
+		t.Errorf("expected all cells to be flushed after resizing, got %d", count)
+	}
+}
+
+func TestVirtualScreen_WideRunes(t *testing.T) {
+	vs := NewVirtualScreen(4, 1)
+	vs.SetContent(0, 0, '世', nil, tcell.StyleDefault)
+	vs.SetContent(2, 0, 'e', []rune{'\u0301'}, tcell.StyleDefault)
+	if mainc, _, _, width := vs.GetContent(0, 0); mainc != '世' || width != 2 {
+		t.Errorf("expected a wide cell, got %q with width %d", mainc, width)
+	}
+	// The continuation column keeps its own content, which the terminal skips when drawing the wide rune.
+	if mainc, _, _, width := vs.GetContent(1, 0); mainc != ' ' || width != 1 {
+		t.Errorf("expected the continuation column to be untouched, got %q with width %d", mainc, width)
+	}
+	if mainc, combc, _, width := vs.GetContent(2, 0); mainc != 'e' || !slices.Equal(combc, []rune{'\u0301'}) || width != 1 {
+		t.Errorf("expected combining characters to be kept, got %q %q with width %d", mainc, combc, width)
+	}
+
+	sim := tcell.NewSimulationScreen("UTF-8")
+	if err := sim.Init(); err != nil {
+		t.Fatalf("failed to init simulation screen: %v", err)
+	}
+	defer sim.Fini()
+	sim.SetSize(4, 1)
+	vs.Flush(sim)
+	sim.Show()
+	cells, _, _ := sim.GetContents()
+	if string(cells[0].Runes) != "世" || string(cells[2].Runes) != "e\u0301" || string(cells[3].Runes) != " " {
+		t.Errorf("unexpected flushed content %q", []string{string(cells[0].Runes), string(cells[2].Runes), string(cells[3].Runes)})
+	}
+
+	// Replacing the wide rune with a narrow one frees the continuation column.
+	vs.SetContent(0, 0, 'a', nil, tcell.StyleDefault)
+	if count := vs.Flush(sim); count != 1 {
+		t.Errorf("expected only the changed cell to be flushed, got %d", count)
+	}
+	sim.Show()
+	cells, _, _ = sim.GetContents()
+	if string(cells[0].Runes) != "a" || string(cells[1].Runes) != " " {
+		t.Errorf("unexpected flushed content %q", []string{string(cells[0].Runes), string(cells[1].Runes)})
+	}
+}
+
+func TestVirtualScreen_Clear(t *testing.T) {
+	vs := NewVirtualScreen(3, 2)
+	vs.SetContent(1, 0, 'a', nil, tcell.StyleDefault)
+	vs.SetContent(2, 1, 'b', nil, tcell.StyleDefault)
+	vs.Flush(NewVirtualScreen(3, 2))
+
+	vs.Clear()
+	if damage := vs.Damage(); damage != (Rect{X: 1, Y: 0, Width: 2, Height: 2}) {
+		t.Errorf("expected clearing to only damage cells that had content, got %v", damage)
+	}
+	if vs.IsDirty(0, 0) || !vs.IsDirty(1, 0) || !vs.IsDirty(2, 1) {
+		t.Errorf("expected only the previously filled cells to be dirty")
+	}
+	if snapshot := vs.Snapshot(); snapshot != NewVirtualScreen(3, 2).Snapshot() {
+		t.Errorf("expected the screen to be blank after clearing, got\n%s", snapshot)
+	}
+
+	style := tcell.StyleDefault.Background(tcell.ColorBlue)
+	vs.Flush(NewVirtualScreen(3, 2))
+	vs.SetStyle(style)
+	vs.Clear()
+	if _, _, cellStyle, _ := vs.GetContent(0, 0); cellStyle != style || vs.Damage() != (Rect{Width: 3, Height: 2}) {
+		t.Errorf("expected clearing to use the screen style")
+	}
+}